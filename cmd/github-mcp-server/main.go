@@ -1,7 +1,6 @@
 package main
 
 import (
-	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -33,59 +32,18 @@ var (
 		Short: "Start stdio server",
 		Long:  `Start a server that communicates via standard input/output streams using JSON-RPC messages.`,
 		RunE: func(_ *cobra.Command, _ []string) error {
-			token := viper.GetString("personal_access_token")
-			if token == "" {
-				return errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+			stdioServerConfig, err := loadStdioServerConfig()
+			if err != nil {
+				return err
 			}
-
-			// If you're wondering why we're not using viper.GetStringSlice("toolsets"),
-			// it's because viper doesn't handle comma-separated values correctly for env
-			// vars when using GetStringSlice.
-			// https://github.com/spf13/viper/issues/380
-			//
-			// Additionally, viper.UnmarshalKey returns an empty slice even when the flag
-			// is not set, but we need nil to indicate "use defaults". So we check IsSet first.
-			var enabledToolsets []string
-			if viper.IsSet("toolsets") {
-				if err := viper.UnmarshalKey("toolsets", &enabledToolsets); err != nil {
-					return fmt.Errorf("failed to unmarshal toolsets: %w", err)
-				}
-			}
-			// else: enabledToolsets stays nil, meaning "use defaults"
-
-			// Parse tools (similar to toolsets)
-			var enabledTools []string
-			if viper.IsSet("tools") {
-				if err := viper.UnmarshalKey("tools", &enabledTools); err != nil {
-					return fmt.Errorf("failed to unmarshal tools: %w", err)
-				}
+			stdioServerConfig.ResponseCache, err = newResponseCache()
+			if err != nil {
+				return err
 			}
-
-			// Parse enabled features (similar to toolsets)
-			var enabledFeatures []string
-			if viper.IsSet("features") {
-				if err := viper.UnmarshalKey("features", &enabledFeatures); err != nil {
-					return fmt.Errorf("failed to unmarshal features: %w", err)
-				}
-			}
-
-			ttl := viper.GetDuration("repo-access-cache-ttl")
-			stdioServerConfig := ghmcp.StdioServerConfig{
-				Version:              version,
-				Host:                 viper.GetString("host"),
-				Token:                token,
-				EnabledToolsets:      enabledToolsets,
-				EnabledTools:         enabledTools,
-				EnabledFeatures:      enabledFeatures,
-				DynamicToolsets:      viper.GetBool("dynamic_toolsets"),
-				ReadOnly:             viper.GetBool("read-only"),
-				ExportTranslations:   viper.GetBool("export-translations"),
-				EnableCommandLogging: viper.GetBool("enable-command-logging"),
-				LogFilePath:          viper.GetString("log-file"),
-				ContentWindowSize:    viper.GetInt("content-window-size"),
-				LockdownMode:         viper.GetBool("lockdown-mode"),
-				InsidersMode:         viper.GetBool("insiders"),
-				RepoAccessCacheTTL:   &ttl,
+			stdioServerConfig.Telemetry = telemetryConfig()
+			// Re-read the config file on SIGHUP so clients don't need to restart the server
+			if viper.GetString("config") != "" {
+				stdioServerConfig.ReloadConfig = loadStdioServerConfig
 			}
 			return ghmcp.RunStdioServer(stdioServerConfig)
 		},
@@ -96,6 +54,21 @@ var (
 		Short: "Start HTTP server",
 		Long:  `Start an HTTP server that listens for MCP requests over HTTP.`,
 		RunE: func(_ *cobra.Command, _ []string) error {
+			repoPolicy, err := loadRepoPolicy(nil)
+			if err != nil {
+				return err
+			}
+
+			responseCache, err := newResponseCache()
+			if err != nil {
+				return err
+			}
+
+			confirmation, err := loadConfirmationPolicy(nil)
+			if err != nil {
+				return err
+			}
+
 			ttl := viper.GetDuration("repo-access-cache-ttl")
 			httpConfig := ghhttp.ServerConfig{
 				Version:              version,
@@ -108,8 +81,16 @@ var (
 				LogFilePath:          viper.GetString("log-file"),
 				ContentWindowSize:    viper.GetInt("content-window-size"),
 				LockdownMode:         viper.GetBool("lockdown-mode"),
+				DryRun:               viper.GetBool("dry-run"),
 				RepoAccessCacheTTL:   &ttl,
 				ScopeChallenge:       viper.GetBool("scope-challenge"),
+				RepoPolicy:           repoPolicy,
+				Confirmation:         confirmation,
+				ResponseCache:        responseCache,
+				Telemetry:            telemetryConfig(),
+				StatefulSessions:     viper.GetBool("stateful-sessions"),
+				SessionIdleTimeout:   viper.GetDuration("session-idle-timeout"),
+				MaxSessionsPerToken:  viper.GetInt("max-sessions-per-token"),
 			}
 
 			return ghhttp.RunHTTPServer(httpConfig)
@@ -129,6 +110,7 @@ func init() {
 	rootCmd.PersistentFlags().StringSlice("features", nil, "Comma-separated list of feature flags to enable")
 	rootCmd.PersistentFlags().Bool("dynamic-toolsets", false, "Enable dynamic toolsets")
 	rootCmd.PersistentFlags().Bool("read-only", false, "Restrict the server to read-only operations")
+	rootCmd.PersistentFlags().Bool("dry-run", false, "Make write tools return the GitHub API request they would send instead of sending it")
 	rootCmd.PersistentFlags().String("log-file", "", "Path to log file")
 	rootCmd.PersistentFlags().Bool("enable-command-logging", false, "When enabled, the server will log all command requests and responses to the log file")
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
@@ -137,12 +119,29 @@ func init() {
 	rootCmd.PersistentFlags().Bool("lockdown-mode", false, "Enable lockdown mode")
 	rootCmd.PersistentFlags().Bool("insiders", false, "Enable insiders features")
 	rootCmd.PersistentFlags().Duration("repo-access-cache-ttl", 5*time.Minute, "Override the repo access cache TTL (e.g. 1m, 0s to disable)")
+	rootCmd.PersistentFlags().String("repo-policy-file", "", "Path to a YAML or JSON file restricting which repositories tools may access")
+	rootCmd.PersistentFlags().Bool("confirm-destructive", false, "Ask the user to confirm calls to tools with the destructiveHint annotation via elicitation")
+	rootCmd.PersistentFlags().StringSlice("confirm-tools", nil, "Comma-separated list of additional tools, or tool:method pairs, that require user confirmation")
+	rootCmd.PersistentFlags().String("http-cache", "", "Cache REST responses and revalidate them with conditional requests: memory or disk (disabled by default)")
+	rootCmd.PersistentFlags().String("http-cache-dir", "", "Directory for the disk HTTP cache (defaults to the user cache directory)")
+	rootCmd.PersistentFlags().Int("http-cache-size", 64, "Maximum size of the HTTP cache in MiB")
+	rootCmd.PersistentFlags().String("otel-traces-exporter", "", "Export OpenTelemetry traces: otlp or none (disabled by default)")
+	rootCmd.PersistentFlags().String("otel-metrics-exporter", "", "Export OpenTelemetry metrics: otlp, or prometheus to serve /metrics in HTTP mode (disabled by default)")
+
+	// Stdio-specific flags
+	stdioCmd.Flags().String("config", "", "Path to a YAML or JSON configuration file (reloaded on SIGHUP)")
+	stdioCmd.Flags().Int64("app-id", 0, "GitHub App ID to authenticate as instead of a personal access token")
+	stdioCmd.Flags().Int64("app-installation-id", 0, "GitHub App installation ID to mint installation tokens for")
+	stdioCmd.Flags().String("app-private-key-file", "", "Path to the GitHub App private key (PEM)")
 
 	// HTTP-specific flags
 	httpCmd.Flags().Int("port", 8082, "HTTP server port")
 	httpCmd.Flags().String("base-url", "", "Base URL where this server is publicly accessible (for OAuth resource metadata)")
 	httpCmd.Flags().String("base-path", "", "Externally visible base path for the HTTP server (for OAuth resource metadata)")
 	httpCmd.Flags().Bool("scope-challenge", false, "Enable OAuth scope challenge responses")
+	httpCmd.Flags().Bool("stateful-sessions", false, "Keep a long-lived server per Mcp-Session-Id, enabling server-initiated messages and stream resumption")
+	httpCmd.Flags().Duration("session-idle-timeout", ghhttp.DefaultSessionIdleTimeout, "Close stateful sessions that receive no requests for this long")
+	httpCmd.Flags().Int("max-sessions-per-token", ghhttp.DefaultMaxSessionsPerToken, "Maximum concurrent stateful sessions per token (0 for no limit)")
 
 	// Bind flag to viper
 	_ = viper.BindPFlag("toolsets", rootCmd.PersistentFlags().Lookup("toolsets"))
@@ -150,6 +149,7 @@ func init() {
 	_ = viper.BindPFlag("features", rootCmd.PersistentFlags().Lookup("features"))
 	_ = viper.BindPFlag("dynamic_toolsets", rootCmd.PersistentFlags().Lookup("dynamic-toolsets"))
 	_ = viper.BindPFlag("read-only", rootCmd.PersistentFlags().Lookup("read-only"))
+	_ = viper.BindPFlag("dry-run", rootCmd.PersistentFlags().Lookup("dry-run"))
 	_ = viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
 	_ = viper.BindPFlag("enable-command-logging", rootCmd.PersistentFlags().Lookup("enable-command-logging"))
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
@@ -158,10 +158,25 @@ func init() {
 	_ = viper.BindPFlag("lockdown-mode", rootCmd.PersistentFlags().Lookup("lockdown-mode"))
 	_ = viper.BindPFlag("insiders", rootCmd.PersistentFlags().Lookup("insiders"))
 	_ = viper.BindPFlag("repo-access-cache-ttl", rootCmd.PersistentFlags().Lookup("repo-access-cache-ttl"))
+	_ = viper.BindPFlag("repo-policy-file", rootCmd.PersistentFlags().Lookup("repo-policy-file"))
+	_ = viper.BindPFlag("confirm-destructive", rootCmd.PersistentFlags().Lookup("confirm-destructive"))
+	_ = viper.BindPFlag("confirm-tools", rootCmd.PersistentFlags().Lookup("confirm-tools"))
+	_ = viper.BindPFlag("http-cache", rootCmd.PersistentFlags().Lookup("http-cache"))
+	_ = viper.BindPFlag("http-cache-dir", rootCmd.PersistentFlags().Lookup("http-cache-dir"))
+	_ = viper.BindPFlag("http-cache-size", rootCmd.PersistentFlags().Lookup("http-cache-size"))
+	_ = viper.BindPFlag("otel-traces-exporter", rootCmd.PersistentFlags().Lookup("otel-traces-exporter"))
+	_ = viper.BindPFlag("otel-metrics-exporter", rootCmd.PersistentFlags().Lookup("otel-metrics-exporter"))
+	_ = viper.BindPFlag("config", stdioCmd.Flags().Lookup("config"))
+	_ = viper.BindPFlag("app-id", stdioCmd.Flags().Lookup("app-id"))
+	_ = viper.BindPFlag("app-installation-id", stdioCmd.Flags().Lookup("app-installation-id"))
+	_ = viper.BindPFlag("app-private-key-file", stdioCmd.Flags().Lookup("app-private-key-file"))
 	_ = viper.BindPFlag("port", httpCmd.Flags().Lookup("port"))
 	_ = viper.BindPFlag("base-url", httpCmd.Flags().Lookup("base-url"))
 	_ = viper.BindPFlag("base-path", httpCmd.Flags().Lookup("base-path"))
 	_ = viper.BindPFlag("scope-challenge", httpCmd.Flags().Lookup("scope-challenge"))
+	_ = viper.BindPFlag("stateful-sessions", httpCmd.Flags().Lookup("stateful-sessions"))
+	_ = viper.BindPFlag("session-idle-timeout", httpCmd.Flags().Lookup("session-idle-timeout"))
+	_ = viper.BindPFlag("max-sessions-per-token", httpCmd.Flags().Lookup("max-sessions-per-token"))
 	// Add subcommands
 	rootCmd.AddCommand(stdioCmd)
 	rootCmd.AddCommand(httpCmd)
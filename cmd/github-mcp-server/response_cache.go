@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/github/github-mcp-server/pkg/http/transport"
+	"github.com/spf13/viper"
+)
+
+// newResponseCache creates the conditional request cache selected by --http-cache,
+// or returns nil if caching is disabled.
+func newResponseCache() (transport.CacheStore, error) {
+	maxBytes := int64(viper.GetInt("http-cache-size")) << 20
+
+	switch mode := viper.GetString("http-cache"); mode {
+	case "":
+		return nil, nil
+	case "memory":
+		return transport.NewMemoryCacheStore(maxBytes), nil
+	case "disk":
+		dir := viper.GetString("http-cache-dir")
+		if dir == "" {
+			cacheDir, err := os.UserCacheDir()
+			if err != nil {
+				return nil, fmt.Errorf("failed to determine cache directory, set --http-cache-dir: %w", err)
+			}
+			dir = filepath.Join(cacheDir, "github-mcp-server", "http")
+		}
+		store, err := transport.NewDiskCacheStore(dir, maxBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create HTTP cache: %w", err)
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("invalid --http-cache value %q: must be memory or disk", mode)
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/github/github-mcp-server/internal/ghmcp"
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/spf13/viper"
+)
+
+// loadStdioServerConfig builds the stdio server configuration from flags,
+// environment variables and, if --config is set, the configuration file.
+// Flags and environment variables take precedence over values in the file.
+func loadStdioServerConfig() (ghmcp.StdioServerConfig, error) {
+	var fileCfg ghmcp.FileConfig
+	if path := viper.GetString("config"); path != "" {
+		loaded, err := ghmcp.LoadConfigFile(path)
+		if err != nil {
+			return ghmcp.StdioServerConfig{}, err
+		}
+		fileCfg = *loaded
+	}
+
+	token := viper.GetString("personal_access_token")
+	appID := viper.GetInt64("app-id")
+	if token == "" && appID == 0 {
+		return ghmcp.StdioServerConfig{}, errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+	}
+
+	// GitHub App authentication takes precedence over a personal access token
+	var appPrivateKey []byte
+	if appID != 0 {
+		keyPath := viper.GetString("app-private-key-file")
+		if keyPath == "" {
+			return ghmcp.StdioServerConfig{}, errors.New("GITHUB_APP_PRIVATE_KEY_FILE must be set when GITHUB_APP_ID is set")
+		}
+		var err error
+		// #nosec G304 - keyPath is provided by the operator via flag or env var
+		appPrivateKey, err = os.ReadFile(keyPath)
+		if err != nil {
+			return ghmcp.StdioServerConfig{}, fmt.Errorf("failed to read GitHub App private key: %w", err)
+		}
+	}
+
+	// If you're wondering why we're not using viper.GetStringSlice("toolsets"),
+	// it's because viper doesn't handle comma-separated values correctly for env
+	// vars when using GetStringSlice.
+	// https://github.com/spf13/viper/issues/380
+	//
+	// Additionally, viper.UnmarshalKey returns an empty slice even when the flag
+	// is not set, but we need nil to indicate "use defaults". So we check IsSet first.
+	enabledToolsets := fileCfg.Toolsets
+	if viper.IsSet("toolsets") {
+		if err := viper.UnmarshalKey("toolsets", &enabledToolsets); err != nil {
+			return ghmcp.StdioServerConfig{}, fmt.Errorf("failed to unmarshal toolsets: %w", err)
+		}
+	}
+	// else: enabledToolsets stays nil unless set in the config file, meaning "use defaults"
+
+	// Parse tools (similar to toolsets)
+	enabledTools := fileCfg.Tools
+	if viper.IsSet("tools") {
+		if err := viper.UnmarshalKey("tools", &enabledTools); err != nil {
+			return ghmcp.StdioServerConfig{}, fmt.Errorf("failed to unmarshal tools: %w", err)
+		}
+	}
+
+	// Parse enabled features (similar to toolsets)
+	enabledFeatures := fileCfg.Features
+	if viper.IsSet("features") {
+		if err := viper.UnmarshalKey("features", &enabledFeatures); err != nil {
+			return ghmcp.StdioServerConfig{}, fmt.Errorf("failed to unmarshal features: %w", err)
+		}
+	}
+
+	repoPolicy, err := loadRepoPolicy(fileCfg.RepoPolicy)
+	if err != nil {
+		return ghmcp.StdioServerConfig{}, err
+	}
+
+	confirmation, err := loadConfirmationPolicy(fileCfg.Confirmation)
+	if err != nil {
+		return ghmcp.StdioServerConfig{}, err
+	}
+
+	ttl := viper.GetDuration("repo-access-cache-ttl")
+	return ghmcp.StdioServerConfig{
+		Version:              version,
+		Host:                 stringSetting("host", fileCfg.Host),
+		Token:                token,
+		AppID:                appID,
+		AppInstallationID:    viper.GetInt64("app-installation-id"),
+		AppPrivateKey:        appPrivateKey,
+		EnabledToolsets:      enabledToolsets,
+		EnabledTools:         enabledTools,
+		EnabledFeatures:      enabledFeatures,
+		DynamicToolsets:      viper.GetBool("dynamic_toolsets"),
+		ReadOnly:             boolSetting("read-only", fileCfg.ReadOnly),
+		DryRun:               viper.GetBool("dry-run"),
+		ExportTranslations:   viper.GetBool("export-translations"),
+		EnableCommandLogging: viper.GetBool("enable-command-logging"),
+		LogFilePath:          viper.GetString("log-file"),
+		ContentWindowSize:    intSetting("content-window-size", fileCfg.ContentWindowSize),
+		LockdownMode:         boolSetting("lockdown-mode", fileCfg.LockdownMode),
+		InsidersMode:         viper.GetBool("insiders"),
+		RepoAccessCacheTTL:   &ttl,
+		ToolsetOverrides:     fileCfg.ToolsetOverrides,
+		RepoPolicy:           repoPolicy,
+		Confirmation:         confirmation,
+	}, nil
+}
+
+// loadRepoPolicy returns the repository policy from --repo-policy-file if set,
+// otherwise the policy from the configuration file, which may be nil.
+func loadRepoPolicy(filePolicy *github.RepoPolicy) (*github.RepoPolicy, error) {
+	path := viper.GetString("repo-policy-file")
+	if path == "" {
+		return filePolicy, nil
+	}
+	return ghmcp.LoadRepoPolicyFile(path)
+}
+
+// loadConfirmationPolicy returns the confirmation policy from --confirm-destructive and
+// --confirm-tools if either is set, otherwise the policy from the configuration file,
+// which may be nil.
+func loadConfirmationPolicy(filePolicy *github.ConfirmationPolicy) (*github.ConfirmationPolicy, error) {
+	if !viper.IsSet("confirm-destructive") && !viper.IsSet("confirm-tools") {
+		return filePolicy, nil
+	}
+
+	policy := github.ConfirmationPolicy{Destructive: viper.GetBool("confirm-destructive")}
+	if err := viper.UnmarshalKey("confirm-tools", &policy.Tools); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal confirm-tools: %w", err)
+	}
+	if err := ghmcp.ValidateConfirmationPolicy(policy); err != nil {
+		return nil, fmt.Errorf("invalid confirm-tools: %w", err)
+	}
+	return &policy, nil
+}
+
+// stringSetting returns the viper value for key if it was set by a flag or
+// environment variable, otherwise the config file value, otherwise the default.
+func stringSetting(key string, fileValue *string) string {
+	if !viper.IsSet(key) && fileValue != nil {
+		return *fileValue
+	}
+	return viper.GetString(key)
+}
+
+// boolSetting is the bool equivalent of stringSetting.
+func boolSetting(key string, fileValue *bool) bool {
+	if !viper.IsSet(key) && fileValue != nil {
+		return *fileValue
+	}
+	return viper.GetBool(key)
+}
+
+// intSetting is the int equivalent of stringSetting.
+func intSetting(key string, fileValue *int) int {
+	if !viper.IsSet(key) && fileValue != nil {
+		return *fileValue
+	}
+	return viper.GetInt(key)
+}
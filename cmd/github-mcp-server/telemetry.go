@@ -0,0 +1,16 @@
+package main
+
+import (
+	"github.com/github/github-mcp-server/pkg/telemetry"
+	"github.com/spf13/viper"
+)
+
+// telemetryConfig returns the exporters selected by --otel-traces-exporter and --otel-metrics-exporter.
+func telemetryConfig() telemetry.Config {
+	return telemetry.Config{
+		ServiceName:     "github-mcp-server",
+		ServiceVersion:  version,
+		TracesExporter:  viper.GetString("otel-traces-exporter"),
+		MetricsExporter: viper.GetString("otel-metrics-exporter"),
+	}
+}
@@ -0,0 +1,181 @@
+package ghmcp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/spf13/viper"
+)
+
+// FileConfig is the declarative configuration file format accepted by --config.
+// YAML and JSON files are supported; the format is chosen by file extension.
+// Pointer fields distinguish "not set" from the zero value so that unset
+// fields fall back to flags, environment variables and defaults.
+//
+// Example (YAML):
+//
+//	host: https://github.example.com
+//	toolsets: [repos, issues, pull_requests]
+//	tools: [get_me]
+//	read_only: false
+//	lockdown_mode: true
+//	content_window_size: 8000
+//	toolset_overrides:
+//	  issues:
+//	    read_only: true
+//	  repos:
+//	    disabled_tools: [delete_file]
+//	repo_policy:
+//	  read:
+//	    allow: [octo-org/*]
+//	  write:
+//	    allow: [octo-org/sandbox]
+//	confirmation:
+//	  destructive: true
+//	  tools: [merge_pull_request, label_write:delete]
+type FileConfig struct {
+	Host              *string                           `mapstructure:"host"`
+	Toolsets          []string                          `mapstructure:"toolsets"`
+	Tools             []string                          `mapstructure:"tools"`
+	Features          []string                          `mapstructure:"features"`
+	ReadOnly          *bool                             `mapstructure:"read_only"`
+	LockdownMode      *bool                             `mapstructure:"lockdown_mode"`
+	ContentWindowSize *int                              `mapstructure:"content_window_size"`
+	ToolsetOverrides  map[string]github.ToolsetOverride `mapstructure:"toolset_overrides"`
+	RepoPolicy        *github.RepoPolicy                `mapstructure:"repo_policy"`
+	Confirmation      *github.ConfirmationPolicy        `mapstructure:"confirmation"`
+}
+
+// LoadConfigFile reads and validates the configuration file at path.
+// Unknown keys are rejected, as are toolsets and tools that do not exist in the inventory.
+func LoadConfigFile(path string) (*FileConfig, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var cfg FileConfig
+	if err := v.UnmarshalExact(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// Validate checks the toolsets and tools referenced by the configuration against the inventory.
+// It returns an error wrapping a ToolsetDoesNotExistError or ToolDoesNotExistError for
+// every unknown name, so all problems are reported at once.
+func (c *FileConfig) Validate() error {
+	// Build() can only fail if WithTools specifies invalid tools - not used here
+	inv, _ := github.NewInventory(translations.NullTranslationHelper).WithToolsets([]string{"all"}).Build()
+
+	var errs []error
+	for _, id := range c.Toolsets {
+		if err := validateToolsetID(inv, strings.TrimSpace(id)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, name := range c.Tools {
+		if err := validateToolName(inv, strings.TrimSpace(name)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for id, override := range c.ToolsetOverrides {
+		if !inv.HasToolset(inventory.ToolsetID(id)) {
+			errs = append(errs, fmt.Errorf("toolset_overrides: %w", inventory.NewToolsetDoesNotExistError(id)))
+			continue
+		}
+		for _, name := range override.DisabledTools {
+			_, toolsetID, err := inv.FindToolByName(name)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("toolset_overrides.%s: %w", id, err))
+				continue
+			}
+			if string(toolsetID) != id {
+				errs = append(errs, fmt.Errorf("toolset_overrides.%s: tool %s belongs to toolset %s", id, name, toolsetID))
+			}
+		}
+	}
+
+	if c.RepoPolicy != nil {
+		if err := c.RepoPolicy.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if c.Confirmation != nil {
+		if err := ValidateConfirmationPolicy(*c.Confirmation); err != nil {
+			errs = append(errs, fmt.Errorf("confirmation: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateConfirmationPolicy checks that the tools listed in the policy exist.
+func ValidateConfirmationPolicy(policy github.ConfirmationPolicy) error {
+	inv, _ := github.NewInventory(translations.NullTranslationHelper).WithToolsets([]string{"all"}).Build()
+
+	var errs []error
+	for _, entry := range policy.Tools {
+		name, _, _ := strings.Cut(strings.TrimSpace(entry), ":")
+		if _, _, err := inv.FindToolByName(name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LoadRepoPolicyFile reads and validates a YAML or JSON repository policy file.
+// The file contains the read and write rules of a github.RepoPolicy at the top level.
+func LoadRepoPolicyFile(path string) (*github.RepoPolicy, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read repository policy file: %w", err)
+	}
+
+	var policy github.RepoPolicy
+	if err := v.UnmarshalExact(&policy); err != nil {
+		return nil, fmt.Errorf("failed to parse repository policy file %s: %w", path, err)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid repository policy file %s: %w", path, err)
+	}
+
+	return &policy, nil
+}
+
+func validateToolsetID(inv *inventory.Inventory, id string) error {
+	switch id {
+	case "", string(github.ToolsetMetadataAll.ID), string(github.ToolsetMetadataDefault.ID):
+		return nil
+	}
+	if !inv.HasToolset(inventory.ToolsetID(id)) {
+		return inventory.NewToolsetDoesNotExistError(id)
+	}
+	return nil
+}
+
+func validateToolName(inv *inventory.Inventory, name string) error {
+	if name == "" {
+		return nil
+	}
+	if _, isAlias := github.DeprecatedToolAliases[name]; isAlias {
+		return nil
+	}
+	_, _, err := inv.FindToolByName(name)
+	return err
+}
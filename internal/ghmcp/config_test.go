@@ -0,0 +1,148 @@
+package ghmcp
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", `
+host: https://github.example.com
+toolsets: [repos, issues]
+tools: [get_me]
+read_only: true
+lockdown_mode: true
+content_window_size: 8000
+toolset_overrides:
+  issues:
+    read_only: true
+  repos:
+    disabled_tools: [delete_file]
+`)
+		cfg, err := LoadConfigFile(path)
+		require.NoError(t, err)
+
+		require.NotNil(t, cfg.Host)
+		assert.Equal(t, "https://github.example.com", *cfg.Host)
+		assert.Equal(t, []string{"repos", "issues"}, cfg.Toolsets)
+		assert.Equal(t, []string{"get_me"}, cfg.Tools)
+		require.NotNil(t, cfg.ReadOnly)
+		assert.True(t, *cfg.ReadOnly)
+		require.NotNil(t, cfg.LockdownMode)
+		assert.True(t, *cfg.LockdownMode)
+		require.NotNil(t, cfg.ContentWindowSize)
+		assert.Equal(t, 8000, *cfg.ContentWindowSize)
+		assert.Equal(t, map[string]github.ToolsetOverride{
+			"issues": {ReadOnly: true},
+			"repos":  {DisabledTools: []string{"delete_file"}},
+		}, cfg.ToolsetOverrides)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		path := writeConfigFile(t, "config.json", `{"toolsets": ["default", "actions"], "read_only": false}`)
+		cfg, err := LoadConfigFile(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"default", "actions"}, cfg.Toolsets)
+		require.NotNil(t, cfg.ReadOnly)
+		assert.False(t, *cfg.ReadOnly)
+		assert.Nil(t, cfg.Host)
+		assert.Nil(t, cfg.LockdownMode)
+	})
+
+	t.Run("unknown toolset", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", "toolsets: [repos, not_a_toolset]\n")
+		_, err := LoadConfigFile(path)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, inventory.NewToolsetDoesNotExistError("not_a_toolset"))
+		assert.Contains(t, err.Error(), "not_a_toolset")
+	})
+
+	t.Run("unknown tool", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", "tools: [not_a_tool]\n")
+		_, err := LoadConfigFile(path)
+		require.Error(t, err)
+		var toolErr *inventory.ToolDoesNotExistError
+		require.True(t, errors.As(err, &toolErr))
+		assert.Equal(t, "not_a_tool", toolErr.Name)
+	})
+
+	t.Run("deprecated tool alias is accepted", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", "tools: [list_workflows]\n")
+		_, err := LoadConfigFile(path)
+		require.NoError(t, err)
+	})
+
+	t.Run("override for tool in another toolset", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", "toolset_overrides:\n  issues:\n    disabled_tools: [delete_file]\n")
+		_, err := LoadConfigFile(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "belongs to toolset repos")
+	})
+
+	t.Run("invalid repo policy pattern", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", "repo_policy:\n  read:\n    allow: [octo-org/*]\n  write:\n    allow: [octo-org/sandbox]\n    deny: [octo-org/sandbox/extra]\n")
+		_, err := LoadConfigFile(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "repo_policy.write.deny")
+	})
+
+	t.Run("confirmation policy", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", "confirmation:\n  destructive: true\n  tools: [merge_pull_request, label_write:delete]\n")
+		cfg, err := LoadConfigFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, &github.ConfirmationPolicy{
+			Destructive: true,
+			Tools:       []string{"merge_pull_request", "label_write:delete"},
+		}, cfg.Confirmation)
+
+		path = writeConfigFile(t, "config.yaml", "confirmation:\n  tools: [not_a_tool:delete]\n")
+		_, err = LoadConfigFile(path)
+		require.Error(t, err)
+		var toolErr *inventory.ToolDoesNotExistError
+		require.True(t, errors.As(err, &toolErr))
+		assert.Equal(t, "not_a_tool", toolErr.Name)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", "readonly: true\n")
+		_, err := LoadConfigFile(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "readonly")
+	})
+}
+
+func TestLoadRepoPolicyFile(t *testing.T) {
+	path := writeConfigFile(t, "policy.yaml", `
+read:
+  allow: [octo-org/*]
+  deny: [octo-org/secrets]
+write:
+  allow: [octo-org/sandbox]
+`)
+	policy, err := LoadRepoPolicyFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, &github.RepoPolicy{
+		Read:  github.RepoPolicyRule{Allow: []string{"octo-org/*"}, Deny: []string{"octo-org/secrets"}},
+		Write: github.RepoPolicyRule{Allow: []string{"octo-org/sandbox"}},
+	}, policy)
+
+	path = writeConfigFile(t, "policy.json", `{"write": {"allow": ["octo-org/a/b"]}}`)
+	_, err = LoadRepoPolicyFile(path)
+	require.Error(t, err)
+}
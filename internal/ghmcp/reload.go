@@ -0,0 +1,115 @@
+package ghmcp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// stdioServer is a stdio MCP server together with the state needed to
+// reconfigure it while clients remain connected.
+type stdioServer struct {
+	server   *mcp.Server
+	deps     *github.BaseDeps
+	features *featureSet
+
+	mu        sync.Mutex
+	cfg       github.MCPServerConfig
+	inventory *inventory.Inventory
+}
+
+// Reload applies the toolsets, tools, features, read-only mode and toolset overrides
+// from cfg to the running server. Tools that are no longer available are removed and
+// newly available tools are registered, which sends notifications/tools/list_changed
+// to connected clients. Other settings, such as the host or lockdown mode, are only
+// read at startup and are ignored here.
+func (s *stdioServer) Reload(ctx context.Context, cfg github.MCPServerConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cfg.DynamicToolsets {
+		return errors.New("configuration reload is not supported with dynamic toolsets")
+	}
+
+	next := s.cfg
+	next.EnabledToolsets = cfg.EnabledToolsets
+	next.EnabledTools = cfg.EnabledTools
+	next.EnabledFeatures = cfg.EnabledFeatures
+	next.ReadOnly = cfg.ReadOnly
+	next.ToolsetOverrides = cfg.ToolsetOverrides
+
+	inv, err := buildInventory(next, newFeatureSet(next.EnabledFeatures).isEnabled)
+	if err != nil {
+		return err
+	}
+
+	if unrecognized := inv.UnrecognizedToolsets(); len(unrecognized) > 0 {
+		s.cfg.Logger.Warn("Warning: unrecognized toolsets ignored", "toolsets", strings.Join(unrecognized, ", "))
+	}
+
+	previous := make(map[string]bool)
+	for _, tool := range s.inventory.AvailableTools(ctx) {
+		previous[tool.Tool.Name] = true
+	}
+
+	available := inv.AvailableTools(ctx)
+	current := make(map[string]bool, len(available))
+	for _, tool := range available {
+		current[tool.Tool.Name] = true
+	}
+
+	var removed []string
+	for name := range previous {
+		if !current[name] {
+			removed = append(removed, name)
+		}
+	}
+
+	s.features.set(next.EnabledFeatures)
+	if len(removed) > 0 {
+		s.server.RemoveTools(removed...)
+	}
+	for i := range available {
+		if !previous[available[i].Tool.Name] {
+			available[i].RegisterFunc(s.server, s.deps)
+		}
+	}
+
+	s.cfg = next
+	s.inventory = inv
+	return nil
+}
+
+// featureSet holds the enabled feature flags. It can be replaced atomically
+// when the configuration is reloaded.
+type featureSet struct {
+	enabled atomic.Pointer[map[string]bool]
+}
+
+// newFeatureSet returns a featureSet with the provided flags enabled.
+// For the local server, this is populated from the --features CLI flag.
+func newFeatureSet(enabledFeatures []string) *featureSet {
+	f := &featureSet{}
+	f.set(enabledFeatures)
+	return f
+}
+
+func (f *featureSet) set(enabledFeatures []string) {
+	// Build a set for O(1) lookup
+	set := make(map[string]bool, len(enabledFeatures))
+	for _, flag := range enabledFeatures {
+		set[flag] = true
+	}
+	f.enabled.Store(&set)
+}
+
+// isEnabled implements inventory.FeatureFlagChecker.
+func (f *featureSet) isEnabled(_ context.Context, flagName string) (bool, error) {
+	return (*f.enabled.Load())[flagName], nil
+}
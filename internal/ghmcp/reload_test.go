@@ -0,0 +1,94 @@
+package ghmcp
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func listToolNames(ctx context.Context, t *testing.T, session *mcp.ClientSession) map[string]bool {
+	t.Helper()
+	result, err := session.ListTools(ctx, nil)
+	require.NoError(t, err)
+	names := make(map[string]bool, len(result.Tools))
+	for _, tool := range result.Tools {
+		names[tool.Name] = true
+	}
+	return names
+}
+
+func TestStdioServerReload(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := newStdioServer(ctx, github.MCPServerConfig{
+		Version:         "test",
+		Token:           "test-token",
+		EnabledToolsets: []string{"issues"},
+		Translator:      translations.NullTranslationHelper,
+		Logger:          slog.New(slog.DiscardHandler),
+	})
+	require.NoError(t, err)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := srv.server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	listChanged := make(chan struct{}, 1)
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, &mcp.ClientOptions{
+		ToolListChangedHandler: func(context.Context, *mcp.ToolListChangedRequest) {
+			select {
+			case listChanged <- struct{}{}:
+			default:
+			}
+		},
+	})
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = session.Close() }()
+
+	before := listToolNames(ctx, t, session)
+	assert.True(t, before["issue_write"])
+	assert.False(t, before["list_label"])
+
+	err = srv.Reload(ctx, github.MCPServerConfig{
+		EnabledToolsets: []string{"issues", "labels"},
+		ToolsetOverrides: map[string]github.ToolsetOverride{
+			"issues": {ReadOnly: true},
+		},
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-listChanged:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for notifications/tools/list_changed")
+	}
+
+	after := listToolNames(ctx, t, session)
+	assert.True(t, after["list_label"], "tools from newly enabled toolset should be registered")
+	assert.True(t, after["issue_read"], "read-only tools should remain")
+	assert.False(t, after["issue_write"], "write tools should be removed from read-only toolset")
+}
+
+func TestStdioServerReloadRejectsDynamicToolsets(t *testing.T) {
+	srv, err := newStdioServer(context.Background(), github.MCPServerConfig{
+		Version:         "test",
+		Token:           "test-token",
+		DynamicToolsets: true,
+		Translator:      translations.NullTranslationHelper,
+		Logger:          slog.New(slog.DiscardHandler),
+	})
+	require.NoError(t, err)
+
+	err = srv.Reload(context.Background(), github.MCPServerConfig{EnabledToolsets: []string{"repos"}})
+	require.Error(t, err)
+}
@@ -14,12 +14,14 @@ import (
 
 	"github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/githubapp"
 	"github.com/github/github-mcp-server/pkg/http/transport"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/lockdown"
 	mcplog "github.com/github/github-mcp-server/pkg/log"
 	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/telemetry"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
 	gogithub "github.com/google/go-github/v82/github"
@@ -59,20 +61,59 @@ func createGitHubClients(cfg github.MCPServerConfig, apiHost utils.APIHostResolv
 	}
 
 	// Construct REST client
-	restClient := gogithub.NewClient(nil).WithAuthToken(cfg.Token)
+	// All clients share a rate limit aware transport so primary and secondary
+	// rate limits are honoured consistently across REST, GraphQL and raw requests.
+	// Telemetry wraps the innermost transport so every attempt, including retries, gets its own span
+	rateLimitTransport := &transport.RateLimitTransport{
+		Transport: cfg.Telemetry.Transport(http.DefaultTransport),
+	}
+	// Write requests of dry-run tool calls are stopped before they are retried or traced
+	apiTransport := &transport.DryRunTransport{
+		Transport: rateLimitTransport,
+	}
+
+	// The conditional request cache sits below the auth transport so entries are keyed per token
+	var restTransport http.RoundTripper = apiTransport
+	if cfg.ResponseCache != nil {
+		restTransport = &transport.ConditionalCacheTransport{
+			Transport: apiTransport,
+			Store:     cfg.ResponseCache,
+		}
+	}
+
+	var restClient *gogithub.Client
+	if cfg.TokenSource != nil {
+		restClient = gogithub.NewClient(&http.Client{
+			Transport: &transport.TokenSourceTransport{
+				Transport: restTransport,
+				Source:    cfg.TokenSource,
+			},
+		})
+	} else {
+		restClient = gogithub.NewClient(&http.Client{Transport: restTransport}).WithAuthToken(cfg.Token)
+	}
 	restClient.UserAgent = fmt.Sprintf("github-mcp-server/%s", cfg.Version)
 	restClient.BaseURL = restURL
 	restClient.UploadURL = uploadURL
 
 	// Construct GraphQL client
 	// We use NewEnterpriseClient unconditionally since we already parsed the API host
+	var gqlTransport http.RoundTripper = &transport.GraphQLFeaturesTransport{
+		Transport: apiTransport,
+	}
+	if cfg.TokenSource != nil {
+		gqlTransport = &transport.TokenSourceTransport{
+			Transport: gqlTransport,
+			Source:    cfg.TokenSource,
+		}
+	} else {
+		gqlTransport = &transport.BearerAuthTransport{
+			Transport: gqlTransport,
+			Token:     cfg.Token,
+		}
+	}
 	gqlHTTPClient := &http.Client{
-		Transport: &transport.BearerAuthTransport{
-			Transport: &transport.GraphQLFeaturesTransport{
-				Transport: http.DefaultTransport,
-			},
-			Token: cfg.Token,
-		},
+		Transport: gqlTransport,
 	}
 
 	gqlClient := githubv4.NewEnterpriseClient(graphQLURL.String(), gqlHTTPClient)
@@ -102,6 +143,15 @@ func createGitHubClients(cfg github.MCPServerConfig, apiHost utils.APIHostResolv
 }
 
 func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Server, error) {
+	s, err := newStdioServer(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return s.server, nil
+}
+
+// newStdioServer creates the stdio MCP server and retains the state needed to reload its configuration.
+func newStdioServer(ctx context.Context, cfg github.MCPServerConfig) (*stdioServer, error) {
 	apiHost, err := utils.NewAPIHost(cfg.Host)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse API host: %w", err)
@@ -113,7 +163,7 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 	}
 
 	// Create feature checker
-	featureChecker := createFeatureChecker(cfg.EnabledFeatures)
+	features := newFeatureSet(cfg.EnabledFeatures)
 
 	// Create dependencies for tool handlers
 	deps := github.NewBaseDeps(
@@ -127,9 +177,40 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 			InsidersMode: cfg.InsidersMode,
 		},
 		cfg.ContentWindowSize,
-		featureChecker,
+		features.isEnabled,
 	)
+
 	// Build and register the tool/resource/prompt inventory
+	inventory, err := buildInventory(cfg, features.isEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	ghServer, err := github.NewMCPServer(ctx, &cfg, deps, inventory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub MCP server: %w", err)
+	}
+
+	// Register MCP App UI resources if available (requires running script/build-ui).
+	// We check availability to allow Insiders mode to work for non-UI features
+	// even when UI assets haven't been built.
+	if cfg.InsidersMode && github.UIAssetsAvailable() {
+		github.RegisterUIResources(ghServer)
+	}
+
+	ghServer.AddReceivingMiddleware(addUserAgentsMiddleware(cfg, clients.rest, clients.gqlHTTP))
+
+	return &stdioServer{
+		server:    ghServer,
+		deps:      deps,
+		features:  features,
+		cfg:       cfg,
+		inventory: inventory,
+	}, nil
+}
+
+// buildInventory builds the tool/resource/prompt inventory for the given configuration.
+func buildInventory(cfg github.MCPServerConfig, featureChecker inventory.FeatureFlagChecker) (*inventory.Inventory, error) {
 	inventoryBuilder := github.NewInventory(cfg.Translator).
 		WithDeprecatedAliases(github.DeprecatedToolAliases).
 		WithReadOnly(cfg.ReadOnly).
@@ -144,26 +225,16 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 		inventoryBuilder = inventoryBuilder.WithFilter(github.CreateToolScopeFilter(cfg.TokenScopes))
 	}
 
-	inventory, err := inventoryBuilder.Build()
-	if err != nil {
-		return nil, fmt.Errorf("failed to build inventory: %w", err)
+	// Apply per-toolset overrides from the configuration file
+	if len(cfg.ToolsetOverrides) > 0 {
+		inventoryBuilder = inventoryBuilder.WithFilter(github.CreateToolsetOverrideFilter(cfg.ToolsetOverrides))
 	}
 
-	ghServer, err := github.NewMCPServer(ctx, &cfg, deps, inventory)
+	inv, err := inventoryBuilder.Build()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create GitHub MCP server: %w", err)
-	}
-
-	// Register MCP App UI resources if available (requires running script/build-ui).
-	// We check availability to allow Insiders mode to work for non-UI features
-	// even when UI assets haven't been built.
-	if cfg.InsidersMode && github.UIAssetsAvailable() {
-		github.RegisterUIResources(ghServer)
+		return nil, fmt.Errorf("failed to build inventory: %w", err)
 	}
-
-	ghServer.AddReceivingMiddleware(addUserAgentsMiddleware(cfg, clients.rest, clients.gqlHTTP))
-
-	return ghServer, nil
+	return inv, nil
 }
 
 type StdioServerConfig struct {
@@ -176,6 +247,17 @@ type StdioServerConfig struct {
 	// GitHub Token to authenticate with the GitHub API
 	Token string
 
+	// AppID is the ID of the GitHub App to authenticate as. When set together with
+	// AppInstallationID and AppPrivateKey, installation tokens are minted and refreshed
+	// automatically and Token is ignored.
+	AppID int64
+
+	// AppInstallationID is the ID of the GitHub App installation to mint tokens for
+	AppInstallationID int64
+
+	// AppPrivateKey is the PEM-encoded private key of the GitHub App
+	AppPrivateKey []byte
+
 	// EnabledToolsets is a list of toolsets to enable
 	// See: https://github.com/github/github-mcp-server?tab=readme-ov-file#tool-configuration
 	EnabledToolsets []string
@@ -195,6 +277,9 @@ type StdioServerConfig struct {
 	// ReadOnly indicates if we should only register read-only tools
 	ReadOnly bool
 
+	// DryRun makes write tools describe the request they would send instead of sending it
+	DryRun bool
+
 	// ExportTranslations indicates if we should export translations
 	// See: https://github.com/github/github-mcp-server?tab=readme-ov-file#i18n--overriding-descriptions
 	ExportTranslations bool
@@ -216,6 +301,27 @@ type StdioServerConfig struct {
 
 	// RepoAccessCacheTTL overrides the default TTL for repository access cache entries.
 	RepoAccessCacheTTL *time.Duration
+
+	// ToolsetOverrides customizes the tools exposed by individual toolsets, keyed by toolset ID
+	ToolsetOverrides map[string]github.ToolsetOverride
+
+	// RepoPolicy, when set, restricts which owners and repositories tool calls may target
+	RepoPolicy *github.RepoPolicy
+
+	// Confirmation, when set, selects tool calls the user must confirm through elicitation
+	Confirmation *github.ConfirmationPolicy
+
+	// ResponseCache, when set, caches REST GET responses using conditional requests
+	ResponseCache transport.CacheStore
+
+	// Telemetry selects the exporters for traces and metrics. The Prometheus
+	// metrics exporter is not supported since the stdio server has no HTTP listener.
+	Telemetry telemetry.Config
+
+	// ReloadConfig, when set, is called on SIGHUP to obtain updated configuration.
+	// Toolsets, tools, features, read-only mode and toolset overrides are applied to
+	// the running server; all other settings require a restart.
+	ReloadConfig func() (StdioServerConfig, error)
 }
 
 // RunStdioServer is not concurrent safe.
@@ -240,7 +346,44 @@ func RunStdioServer(cfg StdioServerConfig) error {
 		slogHandler = slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: slog.LevelInfo})
 	}
 	logger := slog.New(slogHandler)
-	logger.Info("starting server", "version", cfg.Version, "host", cfg.Host, "dynamicToolsets", cfg.DynamicToolsets, "readOnly", cfg.ReadOnly, "lockdownEnabled", cfg.LockdownMode)
+	logger.Info("starting server", "version", cfg.Version, "host", cfg.Host, "dynamicToolsets", cfg.DynamicToolsets, "readOnly", cfg.ReadOnly, "dryRun", cfg.DryRun, "lockdownEnabled", cfg.LockdownMode)
+
+	if cfg.Telemetry.MetricsExporter == telemetry.ExporterPrometheus {
+		return fmt.Errorf("the %q metrics exporter requires the http server; use %q with stdio", telemetry.ExporterPrometheus, telemetry.ExporterOTLP)
+	}
+	telemetryProvider, err := telemetry.NewProvider(ctx, cfg.Telemetry)
+	if err != nil {
+		return fmt.Errorf("failed to configure telemetry: %w", err)
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := telemetryProvider.Shutdown(shutdownCtx); err != nil {
+			logger.Error("failed to flush telemetry", "error", err)
+		}
+	}()
+
+	// Authenticate as a GitHub App installation if configured
+	var tokenSource transport.TokenSource
+	if cfg.AppID != 0 {
+		apiHost, err := utils.NewAPIHost(cfg.Host)
+		if err != nil {
+			return fmt.Errorf("failed to parse API host: %w", err)
+		}
+		tokenSource, err = githubapp.NewInstallationTokenSource(apiHost, githubapp.InstallationTokenOptions{
+			AppID:          cfg.AppID,
+			InstallationID: cfg.AppInstallationID,
+			PrivateKey:     cfg.AppPrivateKey,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to configure GitHub App authentication: %w", err)
+		}
+		// Mint the first token eagerly so misconfiguration fails at startup
+		if _, err := tokenSource.Token(ctx); err != nil {
+			return fmt.Errorf("failed to obtain GitHub App installation token: %w", err)
+		}
+		logger.Info("authenticating as GitHub App installation", "appID", cfg.AppID, "installationID", cfg.AppInstallationID)
+	}
 
 	// Fetch token scopes for scope-based tool filtering (PAT tokens only)
 	// Only classic PATs (ghp_ prefix) return OAuth scopes via X-OAuth-Scopes header.
@@ -258,15 +401,22 @@ func RunStdioServer(cfg StdioServerConfig) error {
 		logger.Debug("skipping scope filtering for non-PAT token")
 	}
 
-	ghServer, err := NewStdioMCPServer(ctx, github.MCPServerConfig{
+	stdioSrv, err := newStdioServer(ctx, github.MCPServerConfig{
 		Version:           cfg.Version,
 		Host:              cfg.Host,
 		Token:             cfg.Token,
+		TokenSource:       tokenSource,
 		EnabledToolsets:   cfg.EnabledToolsets,
 		EnabledTools:      cfg.EnabledTools,
 		EnabledFeatures:   cfg.EnabledFeatures,
 		DynamicToolsets:   cfg.DynamicToolsets,
 		ReadOnly:          cfg.ReadOnly,
+		DryRun:            cfg.DryRun,
+		ToolsetOverrides:  cfg.ToolsetOverrides,
+		RepoPolicy:        cfg.RepoPolicy,
+		Confirmation:      cfg.Confirmation,
+		ResponseCache:     cfg.ResponseCache,
+		Telemetry:         telemetryProvider.Telemetry,
 		Translator:        t,
 		ContentWindowSize: cfg.ContentWindowSize,
 		LockdownMode:      cfg.LockdownMode,
@@ -278,6 +428,7 @@ func RunStdioServer(cfg StdioServerConfig) error {
 	if err != nil {
 		return fmt.Errorf("failed to create MCP server: %w", err)
 	}
+	ghServer := stdioSrv.server
 
 	if cfg.ExportTranslations {
 		// Once server is initialized, all translations are loaded
@@ -303,6 +454,23 @@ func RunStdioServer(cfg StdioServerConfig) error {
 		errC <- ghServer.Run(ctx, &mcp.IOTransport{Reader: in, Writer: out})
 	}()
 
+	// Reload configuration on SIGHUP
+	if cfg.ReloadConfig != nil {
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		defer signal.Stop(hup)
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-hup:
+					reloadStdioServer(ctx, stdioSrv, cfg.ReloadConfig, logger)
+				}
+			}
+		}()
+	}
+
 	// Output github-mcp-server string
 	_, _ = fmt.Fprintf(os.Stderr, "GitHub MCP Server running on stdio\n")
 
@@ -320,18 +488,30 @@ func RunStdioServer(cfg StdioServerConfig) error {
 	return nil
 }
 
-// createFeatureChecker returns a FeatureFlagChecker that checks if a flag name
-// is present in the provided list of enabled features. For the local server,
-// this is populated from the --features CLI flag.
-func createFeatureChecker(enabledFeatures []string) inventory.FeatureFlagChecker {
-	// Build a set for O(1) lookup
-	featureSet := make(map[string]bool, len(enabledFeatures))
-	for _, f := range enabledFeatures {
-		featureSet[f] = true
+// reloadStdioServer loads fresh configuration and applies it to the running server.
+// Errors are logged and the previous configuration is kept.
+func reloadStdioServer(ctx context.Context, s *stdioServer, load func() (StdioServerConfig, error), logger *slog.Logger) {
+	logger.Info("reloading configuration", "signal", "SIGHUP")
+
+	cfg, err := load()
+	if err != nil {
+		logger.Error("failed to reload configuration, keeping previous configuration", "error", err)
+		return
 	}
-	return func(_ context.Context, flagName string) (bool, error) {
-		return featureSet[flagName], nil
+
+	err = s.Reload(ctx, github.MCPServerConfig{
+		EnabledToolsets:  cfg.EnabledToolsets,
+		EnabledTools:     cfg.EnabledTools,
+		EnabledFeatures:  cfg.EnabledFeatures,
+		ReadOnly:         cfg.ReadOnly,
+		ToolsetOverrides: cfg.ToolsetOverrides,
+	})
+	if err != nil {
+		logger.Error("failed to apply reloaded configuration, keeping previous configuration", "error", err)
+		return
 	}
+
+	logger.Info("configuration reloaded", "toolsets", cfg.EnabledToolsets, "tools", cfg.EnabledTools, "readOnly", cfg.ReadOnly)
 }
 
 func addUserAgentsMiddleware(cfg github.MCPServerConfig, restClient *gogithub.Client, gqlHTTPClient *http.Client) func(next mcp.MethodHandler) mcp.MethodHandler {
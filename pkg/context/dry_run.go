@@ -0,0 +1,56 @@
+package context
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// PlannedRequest is a GitHub API request that was not sent because of dry-run mode.
+type PlannedRequest struct {
+	// Method is the HTTP method, e.g. "POST" or "PATCH"
+	Method string `json:"method"`
+	// URL is the full request URL
+	URL string `json:"url"`
+	// Body is the JSON request body. For GraphQL this holds the mutation and its variables.
+	Body json.RawMessage `json:"body,omitempty"`
+	// BodySize is the size in bytes of a request body that is not JSON, such as an upload
+	BodySize int `json:"body_size,omitempty"`
+}
+
+// DryRunRecorder collects the write requests that a tool call would have sent
+// to the GitHub API while handling a single MCP request in dry-run mode.
+type DryRunRecorder struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// Record stores a planned request.
+func (r *DryRunRecorder) Record(req PlannedRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req)
+}
+
+// Requests returns the planned requests in the order they were made.
+func (r *DryRunRecorder) Requests() []PlannedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]PlannedRequest(nil), r.requests...)
+}
+
+// dryRunRecorderCtxKey is a context key for the dry-run recorder
+type dryRunRecorderCtxKey struct{}
+
+// WithDryRunRecorder adds a new DryRunRecorder to the context
+func WithDryRunRecorder(ctx context.Context) (context.Context, *DryRunRecorder) {
+	recorder := &DryRunRecorder{}
+	return context.WithValue(ctx, dryRunRecorderCtxKey{}, recorder), recorder
+}
+
+// GetDryRunRecorder retrieves the dry-run recorder from the context. Write requests
+// made with a context that has a recorder must be recorded instead of sent.
+func GetDryRunRecorder(ctx context.Context) (*DryRunRecorder, bool) {
+	recorder, ok := ctx.Value(dryRunRecorderCtxKey{}).(*DryRunRecorder)
+	return recorder, ok
+}
@@ -0,0 +1,67 @@
+package context
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RateLimit is the GitHub API rate limit status reported by a response.
+type RateLimit struct {
+	// Resource is the rate limit bucket, e.g. "core", "search" or "graphql"
+	Resource string `json:"resource"`
+	// Limit is the maximum number of requests allowed in the window
+	Limit int `json:"limit"`
+	// Remaining is the number of requests left in the window
+	Remaining int `json:"remaining"`
+	// Used is the number of requests made in the window
+	Used int `json:"used"`
+	// Reset is when the window resets
+	Reset time.Time `json:"reset"`
+}
+
+// RateLimitRecorder collects the latest rate limit status per resource
+// for the GitHub API requests made while handling a single MCP request.
+type RateLimitRecorder struct {
+	mu     sync.Mutex
+	limits map[string]RateLimit
+}
+
+// Record stores the rate limit status, replacing any earlier status for the same resource.
+func (r *RateLimitRecorder) Record(limit RateLimit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.limits == nil {
+		r.limits = make(map[string]RateLimit)
+	}
+	r.limits[limit.Resource] = limit
+}
+
+// RateLimits returns the recorded rate limits sorted by resource.
+func (r *RateLimitRecorder) RateLimits() []RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	limits := make([]RateLimit, 0, len(r.limits))
+	for _, limit := range r.limits {
+		limits = append(limits, limit)
+	}
+	sort.Slice(limits, func(i, j int) bool { return limits[i].Resource < limits[j].Resource })
+	return limits
+}
+
+// rateLimitRecorderCtxKey is a context key for the rate limit recorder
+type rateLimitRecorderCtxKey struct{}
+
+// WithRateLimitRecorder adds a new RateLimitRecorder to the context
+func WithRateLimitRecorder(ctx context.Context) (context.Context, *RateLimitRecorder) {
+	recorder := &RateLimitRecorder{}
+	return context.WithValue(ctx, rateLimitRecorderCtxKey{}, recorder), recorder
+}
+
+// RecordRateLimit records the rate limit status on the context's recorder, if any
+func RecordRateLimit(ctx context.Context, limit RateLimit) {
+	if recorder, ok := ctx.Value(rateLimitRecorderCtxKey{}).(*RateLimitRecorder); ok {
+		recorder.Record(limit)
+	}
+}
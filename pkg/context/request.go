@@ -82,6 +82,38 @@ func IsInsidersMode(ctx context.Context) bool {
 	return false
 }
 
+// dryRunCtxKey is a context key for dry-run mode
+type dryRunCtxKey struct{}
+
+// WithDryRun adds dry-run mode state to the context
+func WithDryRun(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, dryRunCtxKey{}, enabled)
+}
+
+// IsDryRun retrieves the dry-run mode state from the context
+func IsDryRun(ctx context.Context) bool {
+	if enabled, ok := ctx.Value(dryRunCtxKey{}).(bool); ok {
+		return enabled
+	}
+	return false
+}
+
+// dynamicToolsetsCtxKey is a context key for dynamic toolsets mode
+type dynamicToolsetsCtxKey struct{}
+
+// WithDynamicToolsets adds dynamic toolsets mode state to the context
+func WithDynamicToolsets(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, dynamicToolsetsCtxKey{}, enabled)
+}
+
+// IsDynamicToolsets retrieves the dynamic toolsets mode state from the context
+func IsDynamicToolsets(ctx context.Context) bool {
+	if enabled, ok := ctx.Value(dynamicToolsetsCtxKey{}).(bool); ok {
+		return enabled
+	}
+	return false
+}
+
 // headerFeaturesCtxKey is a context key for raw header feature flags
 type headerFeaturesCtxKey struct{}
 
@@ -0,0 +1,795 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// Method constants for the actions configuration tools
+const (
+	actionsConfigMethodListVariables          = "list_variables"
+	actionsConfigMethodGetVariable            = "get_variable"
+	actionsConfigMethodListSecrets            = "list_secrets"
+	actionsConfigMethodListEnvironments       = "list_environments"
+	actionsConfigMethodGetEnvironment         = "get_environment"
+	actionsConfigMethodListPendingDeployments = "list_pending_deployments"
+)
+
+// actionsConfigScope identifies where an Actions variable or secret is stored:
+// an organization if repo is empty, an environment if environment is set, and
+// the repository otherwise.
+type actionsConfigScope struct {
+	owner       string
+	repo        string
+	environment string
+}
+
+func actionsConfigScopeFromArgs(args map[string]any) (actionsConfigScope, error) {
+	owner, err := RequiredParam[string](args, "owner")
+	if err != nil {
+		return actionsConfigScope{}, err
+	}
+	repo, err := OptionalParam[string](args, "repo")
+	if err != nil {
+		return actionsConfigScope{}, err
+	}
+	environment, err := OptionalParam[string](args, "environment")
+	if err != nil {
+		return actionsConfigScope{}, err
+	}
+	if environment != "" && repo == "" {
+		return actionsConfigScope{}, fmt.Errorf("repo is required when environment is set")
+	}
+	return actionsConfigScope{owner: owner, repo: repo, environment: environment}, nil
+}
+
+func (s actionsConfigScope) isOrg() bool { return s.repo == "" }
+
+func (s actionsConfigScope) isEnvironment() bool { return s.environment != "" }
+
+// ActionsConfigRead returns the tool and handler for reading Actions variables, secret names and environments.
+func ActionsConfigRead(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "actions_config_read",
+			Description: t("TOOL_ACTIONS_CONFIG_READ_DESCRIPTION", `Read the GitHub Actions configuration of a repository, environment or organization.
+Variables and secrets are read from the organization if repo is omitted, from an environment if environment is provided, and from the repository otherwise. Secret values can never be read, only their names.
+Methods:
+- list_variables: list variables and their values.
+- get_variable: get a variable by name.
+- list_secrets: list the names of secrets.
+- list_environments: list the deployment environments of a repository.
+- get_environment: get an environment with its protection rules (required reviewers, wait timer) and the branches allowed to deploy to it.
+- list_pending_deployments: list the environments a workflow run is waiting for approval to deploy to, and who can approve them.
+`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_ACTIONS_CONFIG_READ_USER_TITLE", "Read GitHub Actions variables, secrets and environments"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "The method to execute",
+						Enum: []any{
+							actionsConfigMethodListVariables,
+							actionsConfigMethodGetVariable,
+							actionsConfigMethodListSecrets,
+							actionsConfigMethodListEnvironments,
+							actionsConfigMethodGetEnvironment,
+							actionsConfigMethodListPendingDeployments,
+						},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner, or the organization for organization variables and secrets",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name. Omit for organization variables and secrets. Required for environment methods.",
+					},
+					"environment": {
+						Type:        "string",
+						Description: "Environment name. Required for 'get_environment'. For variables and secrets, read those of this environment.",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Variable name. Required for 'get_variable'.",
+					},
+					"run_id": {
+						Type:        "number",
+						Description: "The ID of the workflow run. Required for 'list_pending_deployments'.",
+					},
+				},
+				Required: []string{"method", "owner"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo, scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			scope, err := actionsConfigScopeFromArgs(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			opts := &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case actionsConfigMethodListVariables:
+				return listActionsVariables(ctx, client, scope, opts)
+			case actionsConfigMethodGetVariable:
+				return getActionsVariable(ctx, client, scope, args)
+			case actionsConfigMethodListSecrets:
+				return listActionsSecrets(ctx, client, scope, opts)
+			case actionsConfigMethodListEnvironments:
+				return listEnvironments(ctx, client, scope, opts)
+			case actionsConfigMethodGetEnvironment:
+				return getEnvironment(ctx, client, scope)
+			case actionsConfigMethodListPendingDeployments:
+				return listPendingDeployments(ctx, client, scope, args)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+	return tool
+}
+
+// ActionsVariableWrite returns the tool and handler for creating, updating and deleting Actions variables.
+func ActionsVariableWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "actions_variable_write",
+			Description: t("TOOL_ACTIONS_VARIABLE_WRITE_DESCRIPTION", `Create, update or delete a GitHub Actions variable of a repository, environment or organization.
+The variable belongs to the organization if repo is omitted, to an environment if environment is provided, and to the repository otherwise. Variables are not encrypted; use actions_secret_write for sensitive values.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_ACTIONS_VARIABLE_WRITE_USER_TITLE", "Manage GitHub Actions variables"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "Operation to perform",
+						Enum:        []any{"create", "update", "delete"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner, or the organization for organization variables",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name. Omit for organization variables.",
+					},
+					"environment": {
+						Type:        "string",
+						Description: "Environment name, for environment variables",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Variable name",
+					},
+					"value": {
+						Type:        "string",
+						Description: "Variable value. Required for 'create' and 'update'.",
+					},
+					"visibility": {
+						Type:        "string",
+						Description: "Which repositories of the organization can use the variable. Only used for organization variables, and required when creating one.",
+						Enum:        []any{"all", "private", "selected"},
+					},
+					"selected_repository_ids": {
+						Type:        "array",
+						Description: "IDs of the repositories that can use the variable when visibility is 'selected' (e.g. [\"1296269\"])",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+				},
+				Required: []string{"method", "owner", "name"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo, scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			scope, err := actionsConfigScopeFromArgs(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			name, err := RequiredParam[string](args, "name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case "create", "update":
+				return writeActionsVariable(ctx, client, scope, name, method, args)
+			case "delete":
+				return deleteActionsVariable(ctx, client, scope, name)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+	return tool
+}
+
+// ActionsSecretWrite returns the tool and handler for setting and deleting Actions secrets.
+func ActionsSecretWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "actions_secret_write",
+			Description: t("TOOL_ACTIONS_SECRET_WRITE_DESCRIPTION", `Set or delete a GitHub Actions secret of a repository, environment or organization.
+The secret belongs to the organization if repo is omitted, to an environment if environment is provided, and to the repository otherwise.
+Methods:
+- set: create the secret, or replace the value of an existing secret. The value is encrypted with the public key of the repository, environment or organization before it is sent.
+- delete: delete the secret.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_ACTIONS_SECRET_WRITE_USER_TITLE", "Manage GitHub Actions secrets"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "Operation to perform",
+						Enum:        []any{"set", "delete"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner, or the organization for organization secrets",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name. Omit for organization secrets.",
+					},
+					"environment": {
+						Type:        "string",
+						Description: "Environment name, for environment secrets",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Secret name",
+					},
+					"value": {
+						Type:        "string",
+						Description: "Secret value. Required for 'set'.",
+					},
+					"visibility": {
+						Type:        "string",
+						Description: "Which repositories of the organization can use the secret. Only used for organization secrets, and required when setting one.",
+						Enum:        []any{"all", "private", "selected"},
+					},
+					"selected_repository_ids": {
+						Type:        "array",
+						Description: "IDs of the repositories that can use the secret when visibility is 'selected' (e.g. [\"1296269\"])",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+				},
+				Required: []string{"method", "owner", "name"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo, scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			scope, err := actionsConfigScopeFromArgs(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			name, err := RequiredParam[string](args, "name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case "set":
+				return setActionsSecret(ctx, client, scope, name, args)
+			case "delete":
+				return deleteActionsSecret(ctx, client, scope, name)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+	return tool
+}
+
+// ReviewPendingDeployments returns the tool and handler for approving or rejecting deployments waiting for review.
+func ReviewPendingDeployments(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "review_pending_deployments",
+			Description: t("TOOL_REVIEW_PENDING_DEPLOYMENTS_DESCRIPTION", `Approve or reject the deployments of a workflow run that are waiting for review by a required reviewer of their environments.
+Use actions_config_read with method list_pending_deployments to find the environment IDs and whether the current user can approve them.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_REVIEW_PENDING_DEPLOYMENTS_USER_TITLE", "Approve or reject pending deployments"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"run_id": {
+						Type:        "number",
+						Description: "The ID of the workflow run",
+					},
+					"environment_ids": {
+						Type:        "array",
+						Description: "IDs of the environments to approve or reject deployment to (e.g. [\"161088068\"])",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"state": {
+						Type:        "string",
+						Description: "Whether to approve or reject the deployments",
+						Enum:        []any{"approved", "rejected"},
+					},
+					"comment": {
+						Type:        "string",
+						Description: "Comment explaining the review",
+					},
+				},
+				Required: []string{"owner", "repo", "run_id", "environment_ids", "state", "comment"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			runID, err := RequiredBigInt(args, "run_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			environmentIDs, err := OptionalBigIntArrayParam(args, "environment_ids")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if len(environmentIDs) == 0 {
+				return utils.NewToolResultError("missing required parameter: environment_ids"), nil, nil
+			}
+			state, err := RequiredParam[string](args, "state")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			comment, err := RequiredParam[string](args, "comment")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			deployments, resp, err := client.Actions.PendingDeployments(ctx, owner, repo, runID, &github.PendingDeploymentsRequest{
+				EnvironmentIDs: environmentIDs,
+				State:          state,
+				Comment:        comment,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to review pending deployments", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]map[string]any, 0, len(deployments))
+			for _, deployment := range deployments {
+				result = append(result, map[string]any{
+					"id":          deployment.GetID(),
+					"environment": deployment.GetEnvironment(),
+					"ref":         deployment.GetRef(),
+					"sha":         deployment.GetSHA(),
+				})
+			}
+			return MarshalledTextResult(map[string]any{
+				"state":       state,
+				"deployments": result,
+			}), nil, nil
+		},
+	)
+	return tool
+}
+
+func listActionsVariables(ctx context.Context, client *github.Client, scope actionsConfigScope, opts *github.ListOptions) (*mcp.CallToolResult, any, error) {
+	var variables *github.ActionsVariables
+	var resp *github.Response
+	var err error
+	switch {
+	case scope.isOrg():
+		variables, resp, err = client.Actions.ListOrgVariables(ctx, scope.owner, opts)
+	case scope.isEnvironment():
+		variables, resp, err = client.Actions.ListEnvVariables(ctx, scope.owner, scope.repo, scope.environment, opts)
+	default:
+		variables, resp, err = client.Actions.ListRepoVariables(ctx, scope.owner, scope.repo, opts)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list variables", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(variables), nil, nil
+}
+
+func getActionsVariable(ctx context.Context, client *github.Client, scope actionsConfigScope, args map[string]any) (*mcp.CallToolResult, any, error) {
+	name, err := RequiredParam[string](args, "name")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	var variable *github.ActionsVariable
+	var resp *github.Response
+	switch {
+	case scope.isOrg():
+		variable, resp, err = client.Actions.GetOrgVariable(ctx, scope.owner, name)
+	case scope.isEnvironment():
+		variable, resp, err = client.Actions.GetEnvVariable(ctx, scope.owner, scope.repo, scope.environment, name)
+	default:
+		variable, resp, err = client.Actions.GetRepoVariable(ctx, scope.owner, scope.repo, name)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get variable", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(variable), nil, nil
+}
+
+func listActionsSecrets(ctx context.Context, client *github.Client, scope actionsConfigScope, opts *github.ListOptions) (*mcp.CallToolResult, any, error) {
+	var secrets *github.Secrets
+	var resp *github.Response
+	var err error
+	switch {
+	case scope.isOrg():
+		secrets, resp, err = client.Actions.ListOrgSecrets(ctx, scope.owner, opts)
+	case scope.isEnvironment():
+		repoID, idResp, idErr := repositoryID(ctx, client, scope.owner, scope.repo)
+		if idErr != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository", idResp, idErr), nil, nil
+		}
+		secrets, resp, err = client.Actions.ListEnvSecrets(ctx, repoID, scope.environment, opts)
+	default:
+		secrets, resp, err = client.Actions.ListRepoSecrets(ctx, scope.owner, scope.repo, opts)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list secrets", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(secrets), nil, nil
+}
+
+func listEnvironments(ctx context.Context, client *github.Client, scope actionsConfigScope, opts *github.ListOptions) (*mcp.CallToolResult, any, error) {
+	if scope.isOrg() {
+		return utils.NewToolResultError("missing required parameter: repo"), nil, nil
+	}
+
+	environments, resp, err := client.Repositories.ListEnvironments(ctx, scope.owner, scope.repo, &github.EnvironmentListOptions{ListOptions: *opts})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list environments", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	minimalEnvironments := make([]MinimalEnvironment, 0, len(environments.Environments))
+	for _, environment := range environments.Environments {
+		minimalEnvironments = append(minimalEnvironments, convertToMinimalEnvironment(environment))
+	}
+	return MarshalledTextResult(map[string]any{
+		"total_count":  environments.GetTotalCount(),
+		"environments": minimalEnvironments,
+	}), nil, nil
+}
+
+func getEnvironment(ctx context.Context, client *github.Client, scope actionsConfigScope) (*mcp.CallToolResult, any, error) {
+	if scope.isOrg() {
+		return utils.NewToolResultError("missing required parameter: repo"), nil, nil
+	}
+	if !scope.isEnvironment() {
+		return utils.NewToolResultError("missing required parameter: environment"), nil, nil
+	}
+
+	environment, resp, err := client.Repositories.GetEnvironment(ctx, scope.owner, scope.repo, scope.environment)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get environment", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	result := convertToMinimalEnvironment(environment)
+
+	// With custom branch policies, the allowed branch and tag patterns are only available separately
+	if environment.GetDeploymentBranchPolicy().GetCustomBranchPolicies() {
+		policies, resp, err := client.Repositories.ListDeploymentBranchPolicies(ctx, scope.owner, scope.repo, scope.environment)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list deployment branch policies", resp, err), nil, nil
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		for _, policy := range policies.BranchPolicies {
+			result.BranchPolicies = append(result.BranchPolicies, MinimalDeploymentBranchPolicy{
+				Name: policy.GetName(),
+				Type: policy.GetType(),
+			})
+		}
+	}
+
+	return MarshalledTextResult(result), nil, nil
+}
+
+func listPendingDeployments(ctx context.Context, client *github.Client, scope actionsConfigScope, args map[string]any) (*mcp.CallToolResult, any, error) {
+	if scope.isOrg() {
+		return utils.NewToolResultError("missing required parameter: repo"), nil, nil
+	}
+	runID, err := RequiredBigInt(args, "run_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	pending, resp, err := client.Actions.GetPendingDeployments(ctx, scope.owner, scope.repo, runID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pending deployments", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	result := make([]MinimalPendingDeployment, 0, len(pending))
+	for _, deployment := range pending {
+		result = append(result, convertToMinimalPendingDeployment(deployment))
+	}
+	return MarshalledTextResult(result), nil, nil
+}
+
+func writeActionsVariable(ctx context.Context, client *github.Client, scope actionsConfigScope, name, method string, args map[string]any) (*mcp.CallToolResult, any, error) {
+	value, err := RequiredParam[string](args, "value")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	variable := &github.ActionsVariable{Name: name, Value: value}
+	if scope.isOrg() {
+		visibility, err := OptionalParam[string](args, "visibility")
+		if err != nil {
+			return utils.NewToolResultError(err.Error()), nil, nil
+		}
+		if visibility == "" && method == "create" {
+			return utils.NewToolResultError("visibility is required when creating an organization variable"), nil, nil
+		}
+		if visibility != "" {
+			variable.Visibility = github.Ptr(visibility)
+		}
+		repositoryIDs, err := OptionalBigIntArrayParam(args, "selected_repository_ids")
+		if err != nil {
+			return utils.NewToolResultError(err.Error()), nil, nil
+		}
+		if len(repositoryIDs) > 0 {
+			ids := github.SelectedRepoIDs(repositoryIDs)
+			variable.SelectedRepositoryIDs = &ids
+		}
+	}
+
+	var resp *github.Response
+	switch {
+	case scope.isOrg() && method == "create":
+		resp, err = client.Actions.CreateOrgVariable(ctx, scope.owner, variable)
+	case scope.isOrg():
+		resp, err = client.Actions.UpdateOrgVariable(ctx, scope.owner, variable)
+	case scope.isEnvironment() && method == "create":
+		resp, err = client.Actions.CreateEnvVariable(ctx, scope.owner, scope.repo, scope.environment, variable)
+	case scope.isEnvironment():
+		resp, err = client.Actions.UpdateEnvVariable(ctx, scope.owner, scope.repo, scope.environment, variable)
+	case method == "create":
+		resp, err = client.Actions.CreateRepoVariable(ctx, scope.owner, scope.repo, variable)
+	default:
+		resp, err = client.Actions.UpdateRepoVariable(ctx, scope.owner, scope.repo, variable)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to %s variable", method), resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return utils.NewToolResultText(fmt.Sprintf("variable %s %sd successfully", name, method)), nil, nil
+}
+
+func deleteActionsVariable(ctx context.Context, client *github.Client, scope actionsConfigScope, name string) (*mcp.CallToolResult, any, error) {
+	var resp *github.Response
+	var err error
+	switch {
+	case scope.isOrg():
+		resp, err = client.Actions.DeleteOrgVariable(ctx, scope.owner, name)
+	case scope.isEnvironment():
+		resp, err = client.Actions.DeleteEnvVariable(ctx, scope.owner, scope.repo, scope.environment, name)
+	default:
+		resp, err = client.Actions.DeleteRepoVariable(ctx, scope.owner, scope.repo, name)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete variable", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return utils.NewToolResultText(fmt.Sprintf("variable %s deleted successfully", name)), nil, nil
+}
+
+func setActionsSecret(ctx context.Context, client *github.Client, scope actionsConfigScope, name string, args map[string]any) (*mcp.CallToolResult, any, error) {
+	value, err := RequiredParam[string](args, "value")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	secret := &github.EncryptedSecret{Name: name}
+	if scope.isOrg() {
+		visibility, err := RequiredParam[string](args, "visibility")
+		if err != nil {
+			return utils.NewToolResultError("visibility is required for organization secrets"), nil, nil
+		}
+		secret.Visibility = visibility
+		repositoryIDs, err := OptionalBigIntArrayParam(args, "selected_repository_ids")
+		if err != nil {
+			return utils.NewToolResultError(err.Error()), nil, nil
+		}
+		secret.SelectedRepositoryIDs = repositoryIDs
+	}
+
+	// Environment secrets are addressed by repository ID rather than name
+	var repoID int
+	if scope.isEnvironment() {
+		var resp *github.Response
+		repoID, resp, err = repositoryID(ctx, client, scope.owner, scope.repo)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository", resp, err), nil, nil
+		}
+	}
+
+	var key *github.PublicKey
+	var resp *github.Response
+	switch {
+	case scope.isOrg():
+		key, resp, err = client.Actions.GetOrgPublicKey(ctx, scope.owner)
+	case scope.isEnvironment():
+		key, resp, err = client.Actions.GetEnvPublicKey(ctx, repoID, scope.environment)
+	default:
+		key, resp, err = client.Actions.GetRepoPublicKey(ctx, scope.owner, scope.repo)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get public key", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	encryptedValue, err := encryptSecret(key.GetKey(), value)
+	if err != nil {
+		return utils.NewToolResultErrorFromErr("failed to encrypt secret", err), nil, nil
+	}
+	secret.KeyID = key.GetKeyID()
+	secret.EncryptedValue = encryptedValue
+
+	switch {
+	case scope.isOrg():
+		resp, err = client.Actions.CreateOrUpdateOrgSecret(ctx, scope.owner, secret)
+	case scope.isEnvironment():
+		resp, err = client.Actions.CreateOrUpdateEnvSecret(ctx, repoID, scope.environment, secret)
+	default:
+		resp, err = client.Actions.CreateOrUpdateRepoSecret(ctx, scope.owner, scope.repo, secret)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to set secret", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return utils.NewToolResultText(fmt.Sprintf("secret %s set successfully", name)), nil, nil
+}
+
+func deleteActionsSecret(ctx context.Context, client *github.Client, scope actionsConfigScope, name string) (*mcp.CallToolResult, any, error) {
+	var resp *github.Response
+	var err error
+	switch {
+	case scope.isOrg():
+		resp, err = client.Actions.DeleteOrgSecret(ctx, scope.owner, name)
+	case scope.isEnvironment():
+		repoID, idResp, idErr := repositoryID(ctx, client, scope.owner, scope.repo)
+		if idErr != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository", idResp, idErr), nil, nil
+		}
+		resp, err = client.Actions.DeleteEnvSecret(ctx, repoID, scope.environment, name)
+	default:
+		resp, err = client.Actions.DeleteRepoSecret(ctx, scope.owner, scope.repo, name)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete secret", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return utils.NewToolResultText(fmt.Sprintf("secret %s deleted successfully", name)), nil, nil
+}
+
+// repositoryID looks up the numeric ID of a repository.
+func repositoryID(ctx context.Context, client *github.Client, owner, repo string) (int, *github.Response, error) {
+	repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return 0, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return int(repository.GetID()), resp, nil
+}
+
+// encryptSecret encrypts a secret value with a base64 encoded Curve25519 public key
+// using a libsodium compatible sealed box, as required by the Actions secrets API.
+func encryptSecret(publicKey, value string) (string, error) {
+	decodedKey, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid public key: %w", err)
+	}
+	if len(decodedKey) != 32 {
+		return "", fmt.Errorf("invalid public key: expected 32 bytes, got %d", len(decodedKey))
+	}
+	var recipient [32]byte
+	copy(recipient[:], decodedKey)
+
+	sealed, err := box.SealAnonymous(nil, []byte(value), &recipient, rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
@@ -0,0 +1,469 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func Test_ActionsConfigRead(t *testing.T) {
+	toolDef := ActionsConfigRead(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "actions_config_read", toolDef.Tool.Name)
+	assert.True(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	inputSchema := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, inputSchema.Properties, "environment")
+	assert.ElementsMatch(t, inputSchema.Required, []string{"method", "owner"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		check          func(t *testing.T, text string)
+	}{
+		{
+			name: "list repository variables",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposActionsVariablesByOwnerByRepo: mockResponse(t, http.StatusOK, &github.ActionsVariables{
+					TotalCount: 1,
+					Variables:  []*github.ActionsVariable{{Name: "NODE_VERSION", Value: "22"}},
+				}),
+			}),
+			requestArgs: map[string]any{"method": "list_variables", "owner": "owner", "repo": "repo"},
+			check: func(t *testing.T, text string) {
+				assert.Contains(t, text, `"name":"NODE_VERSION","value":"22"`)
+			},
+		},
+		{
+			name: "get environment variable",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposEnvironmentsVariablesByOwnerByRepoByEnvironmentNameByName: expectPath(t, "/repos/owner/repo/environments/production/variables/API_URL").andThen(
+					mockResponse(t, http.StatusOK, &github.ActionsVariable{Name: "API_URL", Value: "https://api.example.com"}),
+				),
+			}),
+			requestArgs: map[string]any{"method": "get_variable", "owner": "owner", "repo": "repo", "environment": "production", "name": "API_URL"},
+			check: func(t *testing.T, text string) {
+				assert.Contains(t, text, "https://api.example.com")
+			},
+		},
+		{
+			name: "list organization secrets",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsActionsSecretsByOrg: mockResponse(t, http.StatusOK, &github.Secrets{
+					TotalCount: 1,
+					Secrets:    []*github.Secret{{Name: "NPM_TOKEN", Visibility: "private"}},
+				}),
+			}),
+			requestArgs: map[string]any{"method": "list_secrets", "owner": "org"},
+			check: func(t *testing.T, text string) {
+				assert.Contains(t, text, `"name":"NPM_TOKEN"`)
+			},
+		},
+		{
+			name: "list environment secrets by repository ID",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposByOwnerByRepo: mockResponse(t, http.StatusOK, &github.Repository{ID: github.Ptr(int64(1296269))}),
+				GetRepositoriesEnvironmentsSecretsByRepositoryIDByEnvironmentName: expectPath(t, "/repositories/1296269/environments/production/secrets").andThen(
+					mockResponse(t, http.StatusOK, &github.Secrets{
+						TotalCount: 1,
+						Secrets:    []*github.Secret{{Name: "DEPLOY_KEY"}},
+					}),
+				),
+			}),
+			requestArgs: map[string]any{"method": "list_secrets", "owner": "owner", "repo": "repo", "environment": "production"},
+			check: func(t *testing.T, text string) {
+				assert.Contains(t, text, `"name":"DEPLOY_KEY"`)
+			},
+		},
+		{
+			name:           "environment without repo",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "list_variables", "owner": "owner", "environment": "production"},
+			expectError:    true,
+			expectedErrMsg: "repo is required when environment is set",
+		},
+		{
+			name: "list environments",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposEnvironmentsByOwnerByRepo: mockResponse(t, http.StatusOK, `{
+					"total_count": 1,
+					"environments": [{
+						"id": 161088068,
+						"name": "production",
+						"protection_rules": [
+							{"id": 3736, "type": "wait_timer", "wait_timer": 30},
+							{"id": 3755, "type": "required_reviewers", "prevent_self_review": true, "reviewers": [
+								{"type": "User", "reviewer": {"login": "octocat", "id": 1}},
+								{"type": "Team", "reviewer": {"slug": "release-managers", "id": 2}}
+							]}
+						],
+						"deployment_branch_policy": {"protected_branches": true, "custom_branch_policies": false}
+					}]
+				}`),
+			}),
+			requestArgs: map[string]any{"method": "list_environments", "owner": "owner", "repo": "repo"},
+			check: func(t *testing.T, text string) {
+				var response struct {
+					TotalCount   int                  `json:"total_count"`
+					Environments []MinimalEnvironment `json:"environments"`
+				}
+				require.NoError(t, json.Unmarshal([]byte(text), &response))
+				require.Len(t, response.Environments, 1)
+				env := response.Environments[0]
+				assert.Equal(t, "production", env.Name)
+				require.Len(t, env.ProtectionRules, 2)
+				assert.Equal(t, 30, env.ProtectionRules[0].WaitTimer)
+				assert.True(t, env.ProtectionRules[1].PreventSelfReview)
+				assert.Equal(t, []string{"octocat", "release-managers"}, env.ProtectionRules[1].Reviewers)
+			},
+		},
+		{
+			name: "get environment with custom branch policies",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposEnvironmentsByOwnerByRepoByEnvironmentName: mockResponse(t, http.StatusOK, `{
+					"id": 161088068,
+					"name": "production",
+					"deployment_branch_policy": {"protected_branches": false, "custom_branch_policies": true}
+				}`),
+				GetReposEnvironmentsDeploymentBranchPoliciesByOwnerByRepoByEnvironmentName: mockResponse(t, http.StatusOK, &github.DeploymentBranchPolicyResponse{
+					TotalCount: github.Ptr(1),
+					BranchPolicies: []*github.DeploymentBranchPolicy{
+						{Name: github.Ptr("release/*"), Type: github.Ptr("branch")},
+					},
+				}),
+			}),
+			requestArgs: map[string]any{"method": "get_environment", "owner": "owner", "repo": "repo", "environment": "production"},
+			check: func(t *testing.T, text string) {
+				var response MinimalEnvironment
+				require.NoError(t, json.Unmarshal([]byte(text), &response))
+				assert.Equal(t, []MinimalDeploymentBranchPolicy{{Name: "release/*", Type: "branch"}}, response.BranchPolicies)
+			},
+		},
+		{
+			name: "list pending deployments",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposActionsRunsPendingDeploymentsByOwnerByRepoByRunID: mockResponse(t, http.StatusOK, `[{
+					"environment": {"id": 161088068, "name": "production"},
+					"wait_timer": 0,
+					"current_user_can_approve": true,
+					"reviewers": [{"type": "User", "reviewer": {"login": "octocat", "id": 1}}]
+				}]`),
+			}),
+			requestArgs: map[string]any{"method": "list_pending_deployments", "owner": "owner", "repo": "repo", "run_id": float64(30433642)},
+			check: func(t *testing.T, text string) {
+				var response []MinimalPendingDeployment
+				require.NoError(t, json.Unmarshal([]byte(text), &response))
+				require.Len(t, response, 1)
+				assert.Equal(t, int64(161088068), response[0].EnvironmentID)
+				assert.True(t, response[0].CurrentUserCanApprove)
+				assert.Equal(t, []string{"octocat"}, response[0].Reviewers)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			tc.check(t, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_ActionsVariableWrite(t *testing.T) {
+	toolDef := ActionsVariableWrite(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "actions_variable_write", toolDef.Tool.Name)
+	assert.False(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, toolDef.Tool.Annotations.DestructiveHint)
+	assert.True(t, *toolDef.Tool.Annotations.DestructiveHint)
+	inputSchema := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	assert.ElementsMatch(t, inputSchema.Required, []string{"method", "owner", "name"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "create repository variable",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposActionsVariablesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"name":  "NODE_VERSION",
+					"value": "22",
+				}).andThen(mockResponse(t, http.StatusCreated, nil)),
+			}),
+			requestArgs:  map[string]any{"method": "create", "owner": "owner", "repo": "repo", "name": "NODE_VERSION", "value": "22"},
+			expectedText: "variable NODE_VERSION created successfully",
+		},
+		{
+			name: "update repository variable",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposActionsVariablesByOwnerByRepoByName: expectPath(t, "/repos/owner/repo/actions/variables/NODE_VERSION").andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			}),
+			requestArgs:  map[string]any{"method": "update", "owner": "owner", "repo": "repo", "name": "NODE_VERSION", "value": "24"},
+			expectedText: "variable NODE_VERSION updated successfully",
+		},
+		{
+			name: "create organization variable for selected repositories",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostOrgsActionsVariablesByOrg: expectRequestBody(t, map[string]any{
+					"name":                    "REGISTRY",
+					"value":                   "ghcr.io",
+					"visibility":              "selected",
+					"selected_repository_ids": []any{float64(1296269), float64(1296270)},
+				}).andThen(mockResponse(t, http.StatusCreated, nil)),
+			}),
+			requestArgs: map[string]any{
+				"method":                  "create",
+				"owner":                   "org",
+				"name":                    "REGISTRY",
+				"value":                   "ghcr.io",
+				"visibility":              "selected",
+				"selected_repository_ids": []any{"1296269", "1296270"},
+			},
+			expectedText: "variable REGISTRY created successfully",
+		},
+		{
+			name:           "create organization variable without visibility",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "create", "owner": "org", "name": "REGISTRY", "value": "ghcr.io"},
+			expectError:    true,
+			expectedErrMsg: "visibility is required when creating an organization variable",
+		},
+		{
+			name: "delete environment variable",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposEnvironmentsVariablesByOwnerByRepoByEnvironmentNameByName: expectPath(t, "/repos/owner/repo/environments/staging/variables/API_URL").andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			}),
+			requestArgs:  map[string]any{"method": "delete", "owner": "owner", "repo": "repo", "environment": "staging", "name": "API_URL"},
+			expectedText: "variable API_URL deleted successfully",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_ActionsSecretWrite(t *testing.T) {
+	toolDef := ActionsSecretWrite(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "actions_secret_write", toolDef.Tool.Name)
+	assert.False(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, toolDef.Tool.Annotations.DestructiveHint)
+	assert.True(t, *toolDef.Tool.Annotations.DestructiveHint)
+
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	encodedKey := base64.StdEncoding.EncodeToString(publicKey[:])
+
+	// expectSealedSecret decrypts the request body with the private key and checks the secret value
+	expectSealedSecret := func(t *testing.T, expectedValue string, expectedFields map[string]any) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			assert.Equal(t, "key-1", body["key_id"])
+			sealed, err := base64.StdEncoding.DecodeString(body["encrypted_value"].(string))
+			require.NoError(t, err)
+			opened, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+			require.True(t, ok, "encrypted_value should open with the repository key")
+			assert.Equal(t, expectedValue, string(opened))
+			for k, v := range expectedFields {
+				assert.Equal(t, v, body[k])
+			}
+			w.WriteHeader(http.StatusCreated)
+		}
+	}
+	publicKeyResponse := &github.PublicKey{KeyID: github.Ptr("key-1"), Key: github.Ptr(encodedKey)}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "set repository secret",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposActionsSecretsPublicKeyByOwnerByRepo:    mockResponse(t, http.StatusOK, publicKeyResponse),
+				PutReposActionsSecretsByOwnerByRepoBySecretName: expectPath(t, "/repos/owner/repo/actions/secrets/NPM_TOKEN").andThen(expectSealedSecret(t, "s3cr3t", nil)),
+			}),
+			requestArgs:  map[string]any{"method": "set", "owner": "owner", "repo": "repo", "name": "NPM_TOKEN", "value": "s3cr3t"},
+			expectedText: "secret NPM_TOKEN set successfully",
+		},
+		{
+			name: "set environment secret",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposByOwnerByRepo: mockResponse(t, http.StatusOK, &github.Repository{ID: github.Ptr(int64(1296269))}),
+				GetRepositoriesEnvironmentsSecretsPublicKeyByRepositoryIDByEnvironmentName: expectPath(t, "/repositories/1296269/environments/production/secrets/public-key").andThen(
+					mockResponse(t, http.StatusOK, publicKeyResponse),
+				),
+				PutRepositoriesEnvironmentsSecretsByRepositoryIDByEnvironmentNameBySecretName: expectSealedSecret(t, "deploy-key", nil),
+			}),
+			requestArgs:  map[string]any{"method": "set", "owner": "owner", "repo": "repo", "environment": "production", "name": "DEPLOY_KEY", "value": "deploy-key"},
+			expectedText: "secret DEPLOY_KEY set successfully",
+		},
+		{
+			name: "set organization secret",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsActionsSecretsPublicKeyByOrg: mockResponse(t, http.StatusOK, publicKeyResponse),
+				PutOrgsActionsSecretsByOrgBySecretName: expectSealedSecret(t, "org-token", map[string]any{
+					"visibility":              "selected",
+					"selected_repository_ids": []any{float64(1296269)},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"method":                  "set",
+				"owner":                   "org",
+				"name":                    "ORG_TOKEN",
+				"value":                   "org-token",
+				"visibility":              "selected",
+				"selected_repository_ids": []any{"1296269"},
+			},
+			expectedText: "secret ORG_TOKEN set successfully",
+		},
+		{
+			name:           "set organization secret without visibility",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "set", "owner": "org", "name": "ORG_TOKEN", "value": "org-token"},
+			expectError:    true,
+			expectedErrMsg: "visibility is required for organization secrets",
+		},
+		{
+			name: "invalid public key",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposActionsSecretsPublicKeyByOwnerByRepo: mockResponse(t, http.StatusOK, &github.PublicKey{KeyID: github.Ptr("key-1"), Key: github.Ptr("c2hvcnQ=")}),
+			}),
+			requestArgs:    map[string]any{"method": "set", "owner": "owner", "repo": "repo", "name": "NPM_TOKEN", "value": "s3cr3t"},
+			expectError:    true,
+			expectedErrMsg: "invalid public key: expected 32 bytes, got 5",
+		},
+		{
+			name: "delete repository secret",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposActionsSecretsByOwnerByRepoBySecretName: mockResponse(t, http.StatusNoContent, nil),
+			}),
+			requestArgs:  map[string]any{"method": "delete", "owner": "owner", "repo": "repo", "name": "NPM_TOKEN"},
+			expectedText: "secret NPM_TOKEN deleted successfully",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_ReviewPendingDeployments(t *testing.T) {
+	toolDef := ReviewPendingDeployments(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "review_pending_deployments", toolDef.Tool.Name)
+	assert.False(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, toolDef.Tool.Annotations.DestructiveHint)
+	assert.True(t, *toolDef.Tool.Annotations.DestructiveHint)
+	inputSchema := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	assert.ElementsMatch(t, inputSchema.Required, []string{"owner", "repo", "run_id", "environment_ids", "state", "comment"})
+
+	t.Run("approve deployment", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PostReposActionsRunsPendingDeploymentsByOwnerByRepoByRunID: expectRequestBody(t, map[string]any{
+				"environment_ids": []any{float64(161088068)},
+				"state":           "approved",
+				"comment":         "Ship it",
+			}).andThen(mockResponse(t, http.StatusOK, []*github.Deployment{
+				{ID: github.Ptr(int64(1)), Environment: github.Ptr("production"), Ref: github.Ptr("main"), SHA: github.Ptr("abc123")},
+			})),
+		})
+		deps := BaseDeps{Client: github.NewClient(mockedClient)}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":           "owner",
+			"repo":            "repo",
+			"run_id":          float64(30433642),
+			"environment_ids": []any{"161088068"},
+			"state":           "approved",
+			"comment":         "Ship it",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"state":"approved"`)
+		assert.Contains(t, text, `"environment":"production"`)
+	})
+
+	t.Run("missing environment ids", func(t *testing.T) {
+		deps := BaseDeps{Client: github.NewClient(MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}))}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":           "owner",
+			"repo":            "repo",
+			"run_id":          float64(30433642),
+			"environment_ids": []any{},
+			"state":           "rejected",
+			"comment":         "Not now",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		assert.Equal(t, "missing required parameter: environment_ids", getErrorResult(t, result).Text)
+	})
+}
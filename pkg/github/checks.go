@@ -0,0 +1,416 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// actionsAppSlug is the slug of the GitHub App that creates the check runs of
+// GitHub Actions jobs. The ID of such a check run is also the ID of its job.
+const actionsAppSlug = "github-actions"
+
+// Method constants for the checks tools
+const (
+	checksMethodListCheckSuites     = "list_check_suites"
+	checksMethodListCheckRuns       = "list_check_runs"
+	checksMethodGetCheckRun         = "get_check_run"
+	checksMethodGetCombinedStatus   = "get_combined_status"
+	checksMethodRerequestCheckSuite = "rerequest_check_suite"
+	checksMethodRerequestCheckRun   = "rerequest_check_run"
+)
+
+// ChecksRead returns the tool and handler for reading check suites, check runs and commit statuses.
+func ChecksRead(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "checks_read",
+			Description: t("TOOL_CHECKS_READ_DESCRIPTION", `Get the CI results of a commit, branch or tag from the Checks API and from commit statuses.
+Most CI systems, including GitHub Actions, report check runs rather than commit statuses.
+Methods:
+- list_check_suites: list the check suites of a ref, one per GitHub App.
+- list_check_runs: list the check runs of a ref, or of a check suite if check_suite_id is provided.
+- get_check_run: get a check run with its output and annotations. For GitHub Actions check runs, the check run ID is also the job ID to use with get_job_logs; set include_logs to get the end of the job log directly.
+- get_combined_status: get the combined commit status of a ref and its individual statuses.
+`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CHECKS_READ_USER_TITLE", "Get check runs, check suites and commit statuses"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "The method to execute",
+						Enum: []any{
+							checksMethodListCheckSuites,
+							checksMethodListCheckRuns,
+							checksMethodGetCheckRun,
+							checksMethodGetCombinedStatus,
+						},
+					},
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Commit SHA, branch name or tag name. Required for 'list_check_suites', 'get_combined_status', and for 'list_check_runs' without check_suite_id.",
+					},
+					"check_suite_id": {
+						Type:        "number",
+						Description: "List the check runs of this check suite instead of a ref. Only used for 'list_check_runs'.",
+					},
+					"check_run_id": {
+						Type:        "number",
+						Description: "The ID of the check run. Required for 'get_check_run'.",
+					},
+					"check_name": {
+						Type:        "string",
+						Description: "Only return check runs with this name, or check suites containing such check runs.",
+					},
+					"status": {
+						Type:        "string",
+						Description: "Only return check runs with this status. Only used for 'list_check_runs'.",
+						Enum:        []any{"queued", "in_progress", "completed"},
+					},
+					"filter": {
+						Type:        "string",
+						Description: "Return only the latest check run of each name, or all of them including re-runs. Only used for 'list_check_runs'.",
+						Enum:        []any{"latest", "all"},
+						Default:     json.RawMessage(`"latest"`),
+					},
+					"include_logs": {
+						Type:        "boolean",
+						Description: "For a GitHub Actions check run, include the end of the job log. Only used for 'get_check_run'.",
+					},
+					"tail_lines": {
+						Type:        "number",
+						Description: "Number of lines to return from the end of the job log when include_logs is true",
+						Default:     json.RawMessage(`500`),
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case checksMethodListCheckSuites:
+				return listCheckSuites(ctx, client, args, owner, repo, pagination)
+			case checksMethodListCheckRuns:
+				return listCheckRuns(ctx, client, args, owner, repo, pagination)
+			case checksMethodGetCheckRun:
+				return getCheckRun(ctx, client, args, owner, repo, pagination, deps.GetContentWindowSize())
+			case checksMethodGetCombinedStatus:
+				return getCombinedStatus(ctx, client, args, owner, repo, pagination)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+	return tool
+}
+
+// ChecksRerequest returns the tool and handler for re-requesting check suites and check runs.
+func ChecksRerequest(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "checks_rerequest",
+			Description: t("TOOL_CHECKS_REREQUEST_DESCRIPTION", `Ask the GitHub App that created a check suite or check run to run it again for the same commit.
+To re-run GitHub Actions workflows, prefer actions_run_trigger.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CHECKS_REREQUEST_USER_TITLE", "Re-request check suites and check runs"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "The method to execute",
+						Enum: []any{
+							checksMethodRerequestCheckSuite,
+							checksMethodRerequestCheckRun,
+						},
+					},
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"check_suite_id": {
+						Type:        "number",
+						Description: "The ID of the check suite. Required for 'rerequest_check_suite'.",
+					},
+					"check_run_id": {
+						Type:        "number",
+						Description: "The ID of the check run. Required for 'rerequest_check_run'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case checksMethodRerequestCheckSuite:
+				checkSuiteID, err := RequiredBigInt(args, "check_suite_id")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				resp, err := client.Checks.ReRequestCheckSuite(ctx, owner, repo, checkSuiteID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to re-request check suite", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return utils.NewToolResultText(fmt.Sprintf("check suite %d has been re-requested", checkSuiteID)), nil, nil
+			case checksMethodRerequestCheckRun:
+				checkRunID, err := RequiredBigInt(args, "check_run_id")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				resp, err := client.Checks.ReRequestCheckRun(ctx, owner, repo, checkRunID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to re-request check run", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return utils.NewToolResultText(fmt.Sprintf("check run %d has been re-requested", checkRunID)), nil, nil
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+	return tool
+}
+
+func listCheckSuites(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+	ref, err := RequiredParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	checkName, err := OptionalParam[string](args, "check_name")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	opts := &github.ListCheckSuiteOptions{
+		ListOptions: github.ListOptions{
+			Page:    pagination.Page,
+			PerPage: pagination.PerPage,
+		},
+	}
+	if checkName != "" {
+		opts.CheckName = github.Ptr(checkName)
+	}
+
+	suites, resp, err := client.Checks.ListCheckSuitesForRef(ctx, owner, repo, ref, opts)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list check suites", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	minimalSuites := make([]MinimalCheckSuite, 0, len(suites.CheckSuites))
+	for _, suite := range suites.CheckSuites {
+		minimalSuites = append(minimalSuites, convertToMinimalCheckSuite(suite))
+	}
+
+	return MarshalledTextResult(map[string]any{
+		"total_count":  suites.GetTotal(),
+		"check_suites": minimalSuites,
+	}), nil, nil
+}
+
+func listCheckRuns(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+	checkSuiteID, err := OptionalIntParam(args, "check_suite_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	checkName, err := OptionalParam[string](args, "check_name")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	status, err := OptionalParam[string](args, "status")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	filter, err := OptionalParam[string](args, "filter")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	opts := &github.ListCheckRunsOptions{
+		ListOptions: github.ListOptions{
+			Page:    pagination.Page,
+			PerPage: pagination.PerPage,
+		},
+	}
+	if checkName != "" {
+		opts.CheckName = github.Ptr(checkName)
+	}
+	if status != "" {
+		opts.Status = github.Ptr(status)
+	}
+	if filter != "" {
+		opts.Filter = github.Ptr(filter)
+	}
+
+	var runs *github.ListCheckRunsResults
+	var resp *github.Response
+	if checkSuiteID != 0 {
+		runs, resp, err = client.Checks.ListCheckRunsCheckSuite(ctx, owner, repo, int64(checkSuiteID), opts)
+	} else {
+		ref, paramErr := RequiredParam[string](args, "ref")
+		if paramErr != nil {
+			return utils.NewToolResultError("either ref or check_suite_id is required for list_check_runs"), nil, nil
+		}
+		runs, resp, err = client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, opts)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list check runs", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	minimalRuns := make([]MinimalCheckRun, 0, len(runs.CheckRuns))
+	for _, run := range runs.CheckRuns {
+		minimalRuns = append(minimalRuns, convertToMinimalCheckRun(run))
+	}
+
+	return MarshalledTextResult(map[string]any{
+		"total_count": runs.GetTotal(),
+		"check_runs":  minimalRuns,
+	}), nil, nil
+}
+
+func getCheckRun(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, pagination PaginationParams, contentWindowSize int) (*mcp.CallToolResult, any, error) {
+	checkRunID, err := RequiredBigInt(args, "check_run_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	includeLogs, err := OptionalParam[bool](args, "include_logs")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	tailLines, err := OptionalIntParamWithDefault(args, "tail_lines", 500)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	checkRun, resp, err := client.Checks.GetCheckRun(ctx, owner, repo, checkRunID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get check run", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	result := CheckRunDetails{
+		MinimalCheckRun: convertToMinimalCheckRun(checkRun),
+		Summary:         checkRun.GetOutput().GetSummary(),
+		Text:            checkRun.GetOutput().GetText(),
+		Annotations:     []MinimalCheckRunAnnotation{},
+	}
+
+	if checkRun.GetOutput().GetAnnotationsCount() > 0 {
+		annotations, resp, err := client.Checks.ListCheckRunAnnotations(ctx, owner, repo, checkRunID, &github.ListOptions{
+			Page:    pagination.Page,
+			PerPage: pagination.PerPage,
+		})
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list check run annotations", resp, err), nil, nil
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		for _, annotation := range annotations {
+			result.Annotations = append(result.Annotations, convertToMinimalCheckRunAnnotation(annotation))
+		}
+	}
+
+	if includeLogs && result.ActionsJobID != 0 {
+		logs, resp, err := getJobLogData(ctx, client, owner, repo, result.ActionsJobID, checkRun.GetName(), true, tailLines, contentWindowSize)
+		if err != nil {
+			// The check run is still useful without its logs
+			result.Logs = map[string]any{"error": err.Error()}
+			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get job logs", resp, err)
+		} else {
+			result.Logs = logs
+		}
+	}
+
+	return MarshalledTextResult(result), nil, nil
+}
+
+func getCombinedStatus(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+	ref, err := RequiredParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, ref, &github.ListOptions{
+		Page:    pagination.Page,
+		PerPage: pagination.PerPage,
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get combined status", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToMinimalCombinedStatus(status)), nil, nil
+}
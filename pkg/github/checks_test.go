@@ -0,0 +1,320 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ChecksRead(t *testing.T) {
+	toolDef := ChecksRead(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "checks_read", toolDef.Tool.Name)
+	assert.True(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	inputSchema := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, inputSchema.Properties, "ref")
+	assert.Contains(t, inputSchema.Properties, "check_run_id")
+	assert.ElementsMatch(t, inputSchema.Required, []string{"method", "owner", "repo"})
+
+	actionsApp := &github.App{Slug: github.Ptr("github-actions")}
+	failedRun := &github.CheckRun{
+		ID:         github.Ptr(int64(42)),
+		Name:       github.Ptr("build"),
+		HeadSHA:    github.Ptr("abc123"),
+		Status:     github.Ptr("completed"),
+		Conclusion: github.Ptr("failure"),
+		App:        actionsApp,
+		CheckSuite: &github.CheckSuite{ID: github.Ptr(int64(7))},
+		Output: &github.CheckRunOutput{
+			Title:            github.Ptr("1 error"),
+			Summary:          github.Ptr("Compilation failed"),
+			AnnotationsCount: github.Ptr(1),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		check          func(t *testing.T, text string)
+	}{
+		{
+			name: "list check suites for ref",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsCheckSuitesByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/commits/main/check-suites").andThen(
+					mockResponse(t, http.StatusOK, &github.ListCheckSuiteResults{
+						Total: github.Ptr(1),
+						CheckSuites: []*github.CheckSuite{
+							{ID: github.Ptr(int64(7)), HeadSHA: github.Ptr("abc123"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure"), App: actionsApp},
+						},
+					}),
+				),
+			}),
+			requestArgs: map[string]any{"method": "list_check_suites", "owner": "owner", "repo": "repo", "ref": "main"},
+			check: func(t *testing.T, text string) {
+				var response struct {
+					TotalCount  int                 `json:"total_count"`
+					CheckSuites []MinimalCheckSuite `json:"check_suites"`
+				}
+				require.NoError(t, json.Unmarshal([]byte(text), &response))
+				assert.Equal(t, 1, response.TotalCount)
+				require.Len(t, response.CheckSuites, 1)
+				assert.Equal(t, "github-actions", response.CheckSuites[0].App)
+				assert.Equal(t, "failure", response.CheckSuites[0].Conclusion)
+			},
+		},
+		{
+			name: "list check runs for ref",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsCheckRunsByOwnerByRepoByRef: expectQueryParams(t, map[string]string{
+					"status":   "completed",
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(mockResponse(t, http.StatusOK, &github.ListCheckRunsResults{
+					Total:     github.Ptr(1),
+					CheckRuns: []*github.CheckRun{failedRun},
+				})),
+			}),
+			requestArgs: map[string]any{"method": "list_check_runs", "owner": "owner", "repo": "repo", "ref": "abc123", "status": "completed"},
+			check: func(t *testing.T, text string) {
+				var response struct {
+					CheckRuns []MinimalCheckRun `json:"check_runs"`
+				}
+				require.NoError(t, json.Unmarshal([]byte(text), &response))
+				require.Len(t, response.CheckRuns, 1)
+				assert.Equal(t, int64(42), response.CheckRuns[0].ActionsJobID)
+				assert.Equal(t, int64(7), response.CheckRuns[0].CheckSuiteID)
+				assert.Equal(t, 1, response.CheckRuns[0].AnnotationsCount)
+			},
+		},
+		{
+			name: "list check runs for check suite",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCheckSuitesCheckRunsByOwnerByRepoByCheckSuiteID: expectPath(t, "/repos/owner/repo/check-suites/7/check-runs").andThen(
+					mockResponse(t, http.StatusOK, &github.ListCheckRunsResults{
+						Total: github.Ptr(1),
+						CheckRuns: []*github.CheckRun{
+							{ID: github.Ptr(int64(43)), Name: github.Ptr("lint"), App: &github.App{Slug: github.Ptr("other-ci")}},
+						},
+					}),
+				),
+			}),
+			requestArgs: map[string]any{"method": "list_check_runs", "owner": "owner", "repo": "repo", "check_suite_id": float64(7)},
+			check: func(t *testing.T, text string) {
+				assert.Contains(t, text, `"name":"lint"`)
+				assert.NotContains(t, text, "actions_job_id")
+			},
+		},
+		{
+			name:           "list check runs without ref or check suite",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "list_check_runs", "owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "either ref or check_suite_id is required for list_check_runs",
+		},
+		{
+			name: "get check run with annotations",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCheckRunsByOwnerByRepoByCheckRunID: mockResponse(t, http.StatusOK, failedRun),
+				GetReposCheckRunsAnnotationsByOwnerByRepoByCheckRunID: mockResponse(t, http.StatusOK, []*github.CheckRunAnnotation{
+					{
+						Path:            github.Ptr("main.go"),
+						StartLine:       github.Ptr(10),
+						EndLine:         github.Ptr(10),
+						AnnotationLevel: github.Ptr("failure"),
+						Message:         github.Ptr("undefined: foo"),
+					},
+				}),
+			}),
+			requestArgs: map[string]any{"method": "get_check_run", "owner": "owner", "repo": "repo", "check_run_id": float64(42)},
+			check: func(t *testing.T, text string) {
+				var response CheckRunDetails
+				require.NoError(t, json.Unmarshal([]byte(text), &response))
+				assert.Equal(t, "Compilation failed", response.Summary)
+				assert.Equal(t, int64(42), response.ActionsJobID)
+				require.Len(t, response.Annotations, 1)
+				assert.Equal(t, "main.go", response.Annotations[0].Path)
+				assert.Equal(t, "undefined: foo", response.Annotations[0].Message)
+				assert.Nil(t, response.Logs)
+			},
+		},
+		{
+			name: "get combined status",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsStatusByOwnerByRepoByRef: mockResponse(t, http.StatusOK, &github.CombinedStatus{
+					State:      github.Ptr("failure"),
+					SHA:        github.Ptr("abc123"),
+					TotalCount: github.Ptr(1),
+					Statuses: []*github.RepoStatus{
+						{Context: github.Ptr("ci/jenkins"), State: github.Ptr("failure"), TargetURL: github.Ptr("https://ci.example.com/1")},
+					},
+				}),
+			}),
+			requestArgs: map[string]any{"method": "get_combined_status", "owner": "owner", "repo": "repo", "ref": "abc123"},
+			check: func(t *testing.T, text string) {
+				var response MinimalCombinedStatus
+				require.NoError(t, json.Unmarshal([]byte(text), &response))
+				assert.Equal(t, "failure", response.State)
+				require.Len(t, response.Statuses, 1)
+				assert.Equal(t, "ci/jenkins", response.Statuses[0].Context)
+			},
+		},
+		{
+			name: "check run not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCheckRunsByOwnerByRepoByCheckRunID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs:    map[string]any{"method": "get_check_run", "owner": "owner", "repo": "repo", "check_run_id": float64(1)},
+			expectError:    true,
+			expectedErrMsg: "failed to get check run",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:            github.NewClient(tc.mockedClient),
+				ContentWindowSize: 5000,
+			}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			tc.check(t, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_ChecksRead_IncludeLogs(t *testing.T) {
+	toolDef := ChecksRead(translations.NullTranslationHelper)
+
+	logServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("step 1\nstep 2\nerror: build failed\n"))
+	}))
+	defer logServer.Close()
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposCheckRunsByOwnerByRepoByCheckRunID: mockResponse(t, http.StatusOK, &github.CheckRun{
+			ID:         github.Ptr(int64(42)),
+			Name:       github.Ptr("build"),
+			Conclusion: github.Ptr("failure"),
+			App:        &github.App{Slug: github.Ptr("github-actions")},
+		}),
+		GetReposActionsJobsLogsByOwnerByRepoByJobID: expectPath(t, "/repos/owner/repo/actions/jobs/42/logs").andThen(
+			func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Location", logServer.URL)
+				w.WriteHeader(http.StatusFound)
+			},
+		),
+	})
+
+	deps := BaseDeps{
+		Client:            github.NewClient(mockedClient),
+		ContentWindowSize: 5000,
+	}
+	handler := toolDef.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"method":       "get_check_run",
+		"owner":        "owner",
+		"repo":         "repo",
+		"check_run_id": float64(42),
+		"include_logs": true,
+		"tail_lines":   float64(2),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+
+	var response CheckRunDetails
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.NotNil(t, response.Logs)
+	assert.Equal(t, "build", response.Logs["job_name"])
+	assert.Contains(t, response.Logs["logs_content"], "error: build failed")
+	assert.NotContains(t, response.Logs["logs_content"], "step 1")
+}
+
+func Test_ChecksRerequest(t *testing.T) {
+	toolDef := ChecksRerequest(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "checks_rerequest", toolDef.Tool.Name)
+	assert.False(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	inputSchema := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	assert.ElementsMatch(t, inputSchema.Required, []string{"method", "owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "rerequest check suite",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposCheckSuitesRerequestByOwnerByRepoByCheckSuiteID: expectPath(t, "/repos/owner/repo/check-suites/7/rerequest").andThen(
+					mockResponse(t, http.StatusCreated, nil),
+				),
+			}),
+			requestArgs:  map[string]any{"method": "rerequest_check_suite", "owner": "owner", "repo": "repo", "check_suite_id": float64(7)},
+			expectedText: "check suite 7 has been re-requested",
+		},
+		{
+			name: "rerequest check run",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposCheckRunsRerequestByOwnerByRepoByCheckRunID: mockResponse(t, http.StatusCreated, nil),
+			}),
+			requestArgs:  map[string]any{"method": "rerequest_check_run", "owner": "owner", "repo": "repo", "check_run_id": float64(42)},
+			expectedText: "check run 42 has been re-requested",
+		},
+		{
+			name:           "missing check suite id",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "rerequest_check_suite", "owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: check_suite_id",
+		},
+		{
+			name: "check run cannot be re-requested",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposCheckRunsRerequestByOwnerByRepoByCheckRunID: mockResponse(t, http.StatusForbidden, `{"message": "This check run is not rerequestable"}`),
+			}),
+			requestArgs:    map[string]any{"method": "rerequest_check_run", "owner": "owner", "repo": "repo", "check_run_id": float64(42)},
+			expectError:    true,
+			expectedErrMsg: "failed to re-request check run",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
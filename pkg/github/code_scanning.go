@@ -1,8 +1,13 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 
@@ -195,3 +200,441 @@ func ListCodeScanningAlerts(t translations.TranslationHelperFunc) inventory.Serv
 		},
 	)
 }
+
+// Method constants for the code scanning read and write tools
+const (
+	codeScanningMethodListAlertInstances = "list_alert_instances"
+	codeScanningMethodListAnalyses       = "list_analyses"
+	codeScanningMethodGetAnalysis        = "get_analysis"
+	codeScanningMethodGetSarif           = "get_sarif"
+	codeScanningMethodGetAutofix         = "get_autofix"
+	codeScanningMethodDismiss            = "dismiss"
+	codeScanningMethodReopen             = "reopen"
+	codeScanningMethodRequestAutofix     = "request_autofix"
+	codeScanningMethodUploadSarif        = "upload_sarif"
+)
+
+// codeScanningAutofix is the status of a Copilot Autofix for a code scanning alert.
+// go-github does not support the autofix endpoints yet.
+type codeScanningAutofix struct {
+	Status      string            `json:"status"`
+	Description *string           `json:"description,omitempty"`
+	StartedAt   *github.Timestamp `json:"started_at,omitempty"`
+}
+
+// CodeScanningRead creates a tool to read the instances, analyses, SARIF uploads and autofixes behind code scanning alerts.
+func CodeScanningRead(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataCodeSecurity,
+		mcp.Tool{
+			Name:        "code_scanning_read",
+			Description: t("TOOL_CODE_SCANNING_READ_DESCRIPTION", "Get information about code scanning in a GitHub repository: the instances of an alert across branches, the analyses and SARIF uploads that produced alerts, and the autofix of an alert."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CODE_SCANNING_READ_USER_TITLE", "Read code scanning analyses and alert instances"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The read operation to perform
+Options are:
+- 'list_alert_instances' - list the instances of an alert, one per branch or pull request it was found in.
+- 'list_analyses' - list code scanning analyses, newest first.
+- 'get_analysis' - get a single analysis.
+- 'get_sarif' - get the processing status of a SARIF upload.
+- 'get_autofix' - get the status of the autofix of an alert.
+`,
+						Enum: []any{
+							codeScanningMethodListAlertInstances,
+							codeScanningMethodListAnalyses,
+							codeScanningMethodGetAnalysis,
+							codeScanningMethodGetSarif,
+							codeScanningMethodGetAutofix,
+						},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"alertNumber": {
+						Type:        "number",
+						Description: "The number of the alert. Required for 'list_alert_instances' and 'get_autofix'.",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Only return results for this Git reference, e.g. 'refs/heads/main' or 'refs/pull/42/merge'. Used for 'list_alert_instances' and 'list_analyses'.",
+					},
+					"analysis_id": {
+						Type:        "number",
+						Description: "The ID of the analysis. Required for 'get_analysis'.",
+					},
+					"sarif_id": {
+						Type:        "string",
+						Description: "The ID of the SARIF upload, as returned by code_scanning_alert_write with method 'upload_sarif'. Required for 'get_sarif', and filters 'list_analyses'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.SecurityEvents},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			switch method {
+			case codeScanningMethodListAlertInstances:
+				return listCodeScanningAlertInstances(ctx, client, args, owner, repo, pagination)
+			case codeScanningMethodListAnalyses:
+				return listCodeScanningAnalyses(ctx, client, args, owner, repo, pagination)
+			case codeScanningMethodGetAnalysis:
+				analysisID, err := RequiredBigInt(args, "analysis_id")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				analysis, resp, err := client.CodeScanning.GetAnalysis(ctx, owner, repo, analysisID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get analysis", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return MarshalledTextResult(analysis), nil, nil
+			case codeScanningMethodGetSarif:
+				sarifID, err := RequiredParam[string](args, "sarif_id")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				upload, resp, err := client.CodeScanning.GetSARIF(ctx, owner, repo, sarifID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get SARIF upload", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return MarshalledTextResult(upload), nil, nil
+			case codeScanningMethodGetAutofix:
+				alertNumber, err := RequiredInt(args, "alertNumber")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				return codeScanningAutofixRequest(ctx, client, http.MethodGet, owner, repo, alertNumber)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+// CodeScanningAlertWrite creates a tool to triage code scanning alerts and upload SARIF results.
+func CodeScanningAlertWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataCodeSecurity,
+		mcp.Tool{
+			Name:        "code_scanning_alert_write",
+			Description: t("TOOL_CODE_SCANNING_ALERT_WRITE_DESCRIPTION", "Triage code scanning alerts in a GitHub repository: dismiss or reopen an alert, request an autofix for it, or upload SARIF results from a code scanning tool."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CODE_SCANNING_ALERT_WRITE_USER_TITLE", "Triage code scanning alerts"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The write operation to perform
+Options are:
+- 'dismiss' - dismiss an open alert, with a reason and an optional comment.
+- 'reopen' - reopen a dismissed alert.
+- 'request_autofix' - request an autofix for an alert. Use code_scanning_read with method 'get_autofix' to follow its status.
+- 'upload_sarif' - upload the results of a code scanning tool in SARIF format. Use code_scanning_read with method 'get_sarif' to follow its processing.
+`,
+						Enum: []any{
+							codeScanningMethodDismiss,
+							codeScanningMethodReopen,
+							codeScanningMethodRequestAutofix,
+							codeScanningMethodUploadSarif,
+						},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"alertNumber": {
+						Type:        "number",
+						Description: "The number of the alert. Required for 'dismiss', 'reopen' and 'request_autofix'.",
+					},
+					"dismissed_reason": {
+						Type:        "string",
+						Description: "The reason for dismissing the alert. Required for 'dismiss'.",
+						Enum:        []any{"false positive", "won't fix", "used in tests"},
+					},
+					"dismissed_comment": {
+						Type:        "string",
+						Description: "A comment explaining why the alert is dismissed, up to 280 characters. Only used for 'dismiss'.",
+					},
+					"commit_sha": {
+						Type:        "string",
+						Description: "The SHA of the commit the SARIF results were produced for. Required for 'upload_sarif'.",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "The full Git reference the SARIF results were produced for, e.g. 'refs/heads/main' or 'refs/pull/42/merge'. Required for 'upload_sarif'.",
+					},
+					"sarif": {
+						Type:        "string",
+						Description: "The SARIF log as JSON text. It is compressed and encoded before upload. Required for 'upload_sarif'.",
+					},
+					"checkout_uri": {
+						Type:        "string",
+						Description: "The URI of the checkout the analysis ran in, used to make the paths in the SARIF log relative. Only used for 'upload_sarif'.",
+					},
+					"tool_name": {
+						Type:        "string",
+						Description: "The name of the tool that produced the SARIF log, if it is not set in the log. Only used for 'upload_sarif'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.SecurityEvents},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			if method == codeScanningMethodUploadSarif {
+				return uploadSarif(ctx, client, args, owner, repo)
+			}
+
+			alertNumber, err := RequiredInt(args, "alertNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			switch method {
+			case codeScanningMethodDismiss:
+				reason, err := RequiredParam[string](args, "dismissed_reason")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				comment, err := OptionalParam[string](args, "dismissed_comment")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				state := &github.CodeScanningAlertState{
+					State:           "dismissed",
+					DismissedReason: github.Ptr(reason),
+				}
+				if comment != "" {
+					state.DismissedComment = github.Ptr(comment)
+				}
+				return updateCodeScanningAlert(ctx, client, owner, repo, alertNumber, state)
+			case codeScanningMethodReopen:
+				return updateCodeScanningAlert(ctx, client, owner, repo, alertNumber, &github.CodeScanningAlertState{State: "open"})
+			case codeScanningMethodRequestAutofix:
+				return codeScanningAutofixRequest(ctx, client, http.MethodPost, owner, repo, alertNumber)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+func listCodeScanningAlertInstances(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+	alertNumber, err := RequiredInt(args, "alertNumber")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	ref, err := OptionalParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	instances, resp, err := client.CodeScanning.ListAlertInstances(ctx, owner, repo, int64(alertNumber), &github.AlertInstancesListOptions{
+		Ref: ref,
+		ListOptions: github.ListOptions{
+			Page:    pagination.Page,
+			PerPage: pagination.PerPage,
+		},
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list alert instances", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(instances), nil, nil
+}
+
+func listCodeScanningAnalyses(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+	ref, err := OptionalParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	sarifID, err := OptionalParam[string](args, "sarif_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	opts := &github.AnalysesListOptions{
+		ListOptions: github.ListOptions{
+			Page:    pagination.Page,
+			PerPage: pagination.PerPage,
+		},
+	}
+	if ref != "" {
+		opts.Ref = github.Ptr(ref)
+	}
+	if sarifID != "" {
+		opts.SarifID = github.Ptr(sarifID)
+	}
+
+	analyses, resp, err := client.CodeScanning.ListAnalysesForRepo(ctx, owner, repo, opts)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list analyses", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(analyses), nil, nil
+}
+
+func updateCodeScanningAlert(ctx context.Context, client *github.Client, owner, repo string, alertNumber int, state *github.CodeScanningAlertState) (*mcp.CallToolResult, any, error) {
+	alert, resp, err := client.CodeScanning.UpdateAlert(ctx, owner, repo, int64(alertNumber), state)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update alert", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(alert), nil, nil
+}
+
+// codeScanningAutofixRequest gets the autofix of an alert, or requests one with a POST.
+// A new autofix is generated asynchronously, which the API reports with 202 Accepted.
+func codeScanningAutofixRequest(ctx context.Context, client *github.Client, method, owner, repo string, alertNumber int) (*mcp.CallToolResult, any, error) {
+	path := fmt.Sprintf("repos/%s/%s/code-scanning/alerts/%d/autofix", owner, repo, alertNumber)
+	req, err := client.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	errMsg := "failed to get autofix"
+	if method == http.MethodPost {
+		errMsg = "failed to request autofix"
+	}
+
+	autofix := new(codeScanningAutofix)
+	resp, err := client.Do(ctx, req, autofix)
+	if err != nil {
+		var acceptedErr *github.AcceptedError
+		if !errors.As(err, &acceptedErr) {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, errMsg, resp, err), nil, nil
+		}
+		if err := json.Unmarshal(acceptedErr.Raw, autofix); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal autofix: %w", err)
+		}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(autofix), nil, nil
+}
+
+func uploadSarif(ctx context.Context, client *github.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	commitSHA, err := RequiredParam[string](args, "commit_sha")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	ref, err := RequiredParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	sarif, err := RequiredParam[string](args, "sarif")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	checkoutURI, err := OptionalParam[string](args, "checkout_uri")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	toolName, err := OptionalParam[string](args, "tool_name")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if !json.Valid([]byte(sarif)) {
+		return utils.NewToolResultError("sarif must be a SARIF log in JSON format"), nil, nil
+	}
+
+	encoded, err := encodeSarif(sarif)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode SARIF log: %w", err)
+	}
+	analysis := &github.SarifAnalysis{
+		CommitSHA: github.Ptr(commitSHA),
+		Ref:       github.Ptr(ref),
+		Sarif:     github.Ptr(encoded),
+	}
+	if checkoutURI != "" {
+		analysis.CheckoutURI = github.Ptr(checkoutURI)
+	}
+	if toolName != "" {
+		analysis.ToolName = github.Ptr(toolName)
+	}
+
+	sarifID, resp, err := client.CodeScanning.UploadSarif(ctx, owner, repo, analysis)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to upload SARIF", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(sarifID), nil, nil
+}
+
+// encodeSarif gzips and base64 encodes a SARIF log, as the upload endpoint expects.
+func encodeSarif(sarif string) (string, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(sarif)); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
@@ -1,8 +1,12 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"io"
 	"net/http"
 	"testing"
 
@@ -247,3 +251,271 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_CodeScanningRead(t *testing.T) {
+	toolDef := CodeScanningRead(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "code_scanning_read", toolDef.Tool.Name)
+	assert.True(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	schema, ok := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "alertNumber")
+	assert.Contains(t, schema.Properties, "analysis_id")
+	assert.Contains(t, schema.Properties, "sarif_id")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "list alert instances on a branch",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCodeScanningAlertsInstancesByOwnerByRepoByAlertNumber: expectQueryParams(t, map[string]string{
+					"ref":      "refs/heads/main",
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(mockResponse(t, http.StatusOK, []*github.MostRecentInstance{
+					{Ref: github.Ptr("refs/heads/main"), State: github.Ptr("open"), CommitSHA: github.Ptr("abc123")},
+				})),
+			}),
+			requestArgs:  map[string]any{"method": "list_alert_instances", "owner": "owner", "repo": "repo", "alertNumber": float64(42), "ref": "refs/heads/main"},
+			expectedText: `[{"ref":"refs/heads/main","state":"open","commit_sha":"abc123"}]`,
+		},
+		{
+			name:           "list alert instances without alert number",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "list_alert_instances", "owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: alertNumber",
+		},
+		{
+			name: "list analyses of a SARIF upload",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCodeScanningAnalysesByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"sarif_id": "47177e22-5596-11eb-80a1-c1e54ef945c6",
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(mockResponse(t, http.StatusOK, []*github.ScanningAnalysis{
+					{ID: github.Ptr(int64(201)), Ref: github.Ptr("refs/heads/main"), ResultsCount: github.Ptr(3)},
+				})),
+			}),
+			requestArgs:  map[string]any{"method": "list_analyses", "owner": "owner", "repo": "repo", "sarif_id": "47177e22-5596-11eb-80a1-c1e54ef945c6"},
+			expectedText: `[{"id":201,"ref":"refs/heads/main","results_count":3}]`,
+		},
+		{
+			name: "get analysis",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCodeScanningAnalysesByOwnerByRepoByAnalysisID: expectPath(t, "/repos/owner/repo/code-scanning/analyses/201").andThen(
+					mockResponse(t, http.StatusOK, &github.ScanningAnalysis{ID: github.Ptr(int64(201)), Tool: &github.Tool{Name: github.Ptr("CodeQL")}}),
+				),
+			}),
+			requestArgs:  map[string]any{"method": "get_analysis", "owner": "owner", "repo": "repo", "analysis_id": float64(201)},
+			expectedText: `{"id":201,"tool":{"name":"CodeQL"}}`,
+		},
+		{
+			name: "get SARIF upload",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCodeScanningSarifsByOwnerByRepoBySarifID: mockResponse(t, http.StatusOK, &github.SARIFUpload{
+					ProcessingStatus: github.Ptr("complete"),
+					AnalysesURL:      github.Ptr("https://api.github.com/repos/owner/repo/code-scanning/analyses?sarif_id=47177e22"),
+				}),
+			}),
+			requestArgs:  map[string]any{"method": "get_sarif", "owner": "owner", "repo": "repo", "sarif_id": "47177e22"},
+			expectedText: `{"processing_status":"complete","analyses_url":"https://api.github.com/repos/owner/repo/code-scanning/analyses?sarif_id=47177e22"}`,
+		},
+		{
+			name: "get autofix",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCodeScanningAlertsAutofixByOwnerByRepoByAlertNumber: mockResponse(t, http.StatusOK, map[string]any{
+					"status":      "success",
+					"description": "Use a parameterized query.",
+				}),
+			}),
+			requestArgs:  map[string]any{"method": "get_autofix", "owner": "owner", "repo": "repo", "alertNumber": float64(42)},
+			expectedText: `{"status":"success","description":"Use a parameterized query."}`,
+		},
+		{
+			name: "get autofix of unsupported alert",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCodeScanningAlertsAutofixByOwnerByRepoByAlertNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs:    map[string]any{"method": "get_autofix", "owner": "owner", "repo": "repo", "alertNumber": float64(42)},
+			expectError:    true,
+			expectedErrMsg: "failed to get autofix",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.JSONEq(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_CodeScanningAlertWrite(t *testing.T) {
+	toolDef := CodeScanningAlertWrite(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "code_scanning_alert_write", toolDef.Tool.Name)
+	assert.False(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	schema, ok := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "dismissed_reason")
+	assert.Contains(t, schema.Properties, "sarif")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo"})
+
+	sarifLog := `{"version":"2.1.0","runs":[{"tool":{"driver":{"name":"linter"}},"results":[]}]}`
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "dismiss alert",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state":             "dismissed",
+					"dismissed_reason":  "false positive",
+					"dismissed_comment": "Input is validated by the caller",
+				}).andThen(mockResponse(t, http.StatusOK, &github.Alert{
+					Number:          github.Ptr(42),
+					State:           github.Ptr("dismissed"),
+					DismissedReason: github.Ptr("false positive"),
+				})),
+			}),
+			requestArgs: map[string]any{
+				"method":            "dismiss",
+				"owner":             "owner",
+				"repo":              "repo",
+				"alertNumber":       float64(42),
+				"dismissed_reason":  "false positive",
+				"dismissed_comment": "Input is validated by the caller",
+			},
+			expectedText: `{"number":42,"state":"dismissed","dismissed_reason":"false positive"}`,
+		},
+		{
+			name:           "dismiss alert without reason",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "dismiss", "owner": "owner", "repo": "repo", "alertNumber": float64(42)},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: dismissed_reason",
+		},
+		{
+			name: "reopen alert",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state": "open",
+				}).andThen(mockResponse(t, http.StatusOK, &github.Alert{Number: github.Ptr(42), State: github.Ptr("open")})),
+			}),
+			requestArgs:  map[string]any{"method": "reopen", "owner": "owner", "repo": "repo", "alertNumber": float64(42)},
+			expectedText: `{"number":42,"state":"open"}`,
+		},
+		{
+			name: "reopen alert without permission",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber: mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+			}),
+			requestArgs:    map[string]any{"method": "reopen", "owner": "owner", "repo": "repo", "alertNumber": float64(42)},
+			expectError:    true,
+			expectedErrMsg: "failed to update alert",
+		},
+		{
+			name: "request autofix",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposCodeScanningAlertsAutofixByOwnerByRepoByAlertNumber: mockResponse(t, http.StatusAccepted, map[string]any{
+					"status":     "pending",
+					"started_at": "2026-10-01T12:00:00Z",
+				}),
+			}),
+			requestArgs:  map[string]any{"method": "request_autofix", "owner": "owner", "repo": "repo", "alertNumber": float64(42)},
+			expectedText: `{"status":"pending","started_at":"2026-10-01T12:00:00Z"}`,
+		},
+		{
+			name: "upload SARIF",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposCodeScanningSarifsByOwnerByRepo: func(w http.ResponseWriter, r *http.Request) {
+					var body struct {
+						CommitSHA string `json:"commit_sha"`
+						Ref       string `json:"ref"`
+						Sarif     string `json:"sarif"`
+					}
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, "abc123", body.CommitSHA)
+					assert.Equal(t, "refs/heads/main", body.Ref)
+
+					compressed, err := base64.StdEncoding.DecodeString(body.Sarif)
+					require.NoError(t, err)
+					zr, err := gzip.NewReader(bytes.NewReader(compressed))
+					require.NoError(t, err)
+					decoded, err := io.ReadAll(zr)
+					require.NoError(t, err)
+					assert.Equal(t, sarifLog, string(decoded))
+
+					w.WriteHeader(http.StatusAccepted)
+					_, _ = w.Write([]byte(`{"id":"47177e22","url":"https://api.github.com/repos/owner/repo/code-scanning/sarifs/47177e22"}`))
+				},
+			}),
+			requestArgs: map[string]any{
+				"method":     "upload_sarif",
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "abc123",
+				"ref":        "refs/heads/main",
+				"sarif":      sarifLog,
+			},
+			expectedText: `{"id":"47177e22","url":"https://api.github.com/repos/owner/repo/code-scanning/sarifs/47177e22"}`,
+		},
+		{
+			name:         "upload invalid SARIF",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":     "upload_sarif",
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "abc123",
+				"ref":        "refs/heads/main",
+				"sarif":      "not json",
+			},
+			expectError:    true,
+			expectedErrMsg: "sarif must be a SARIF log in JSON format",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.JSONEq(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
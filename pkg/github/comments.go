@@ -0,0 +1,270 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Method constants for the comment write tools
+const (
+	commentMethodUpdate = "update"
+	commentMethodDelete = "delete"
+)
+
+// checkLockdownAuthor applies lockdown mode to content that a write tool is
+// about to act on. Content that the read tools would hide, because its author
+// has no push access to the repository, must not be edited, deleted or reacted
+// to either. It returns an error result if the content is restricted, and nil
+// if the tool can go ahead.
+func checkLockdownAuthor(ctx context.Context, deps ToolDependencies, login, owner, repo, subject string) (*mcp.CallToolResult, error) {
+	if !deps.GetFlags(ctx).LockdownMode || login == "" {
+		return nil, nil
+	}
+	cache, err := deps.GetRepoAccessCache(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
+	}
+	if cache == nil {
+		return nil, fmt.Errorf("lockdown cache is not configured")
+	}
+	isSafeContent, err := cache.IsSafeContent(ctx, login, owner, repo)
+	if err != nil {
+		return utils.NewToolResultError(fmt.Sprintf("failed to check lockdown mode: %v", err)), nil
+	}
+	if !isSafeContent {
+		return utils.NewToolResultError(fmt.Sprintf("access to %s is restricted by lockdown mode", subject)), nil
+	}
+	return nil, nil
+}
+
+// IssueCommentWrite creates a tool to update or delete issue comments.
+func IssueCommentWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "issue_comment_write",
+			Description: t("TOOL_ISSUE_COMMENT_WRITE_DESCRIPTION", "Update or delete a comment on an issue or on the conversation of a pull request. To add a comment, use add_issue_comment."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_ISSUE_COMMENT_WRITE_USER_TITLE", "Update or delete issue comment"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The action to perform on the comment
+Options are:
+- 'update' - replace the body of the comment.
+- 'delete' - delete the comment.
+`,
+						Enum: []any{commentMethodUpdate, commentMethodDelete},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"comment_id": {
+						Type:        "number",
+						Description: "The ID of the comment, as returned by issue_read with method get_comments",
+					},
+					"body": {
+						Type:        "string",
+						Description: "New comment content. Required for 'update'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo", "comment_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commentID, err := RequiredBigInt(args, "comment_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			var body string
+			if method == commentMethodUpdate {
+				body, err = RequiredParam[string](args, "body")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			if deps.GetFlags(ctx).LockdownMode {
+				comment, resp, err := client.Issues.GetComment(ctx, owner, repo, commentID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get comment", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+				result, err := checkLockdownAuthor(ctx, deps, comment.GetUser().GetLogin(), owner, repo, "comment")
+				if result != nil || err != nil {
+					return result, nil, err
+				}
+			}
+
+			switch method {
+			case commentMethodUpdate:
+				comment, resp, err := client.Issues.EditComment(ctx, owner, repo, commentID, &github.IssueComment{Body: github.Ptr(body)})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update comment", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return MarshalledTextResult(MinimalResponse{
+					ID:  strconv.FormatInt(comment.GetID(), 10),
+					URL: comment.GetHTMLURL(),
+				}), nil, nil
+			case commentMethodDelete:
+				resp, err := client.Issues.DeleteComment(ctx, owner, repo, commentID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete comment", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return utils.NewToolResultText(fmt.Sprintf("comment %d deleted successfully", commentID)), nil, nil
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		})
+}
+
+// PullRequestReviewCommentWrite creates a tool to update or delete pull request review comments.
+func PullRequestReviewCommentWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "pull_request_review_comment_write",
+			Description: t("TOOL_PULL_REQUEST_REVIEW_COMMENT_WRITE_DESCRIPTION", "Update or delete a review comment on the diff of a pull request. For comments on the conversation of a pull request, use issue_comment_write."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_PULL_REQUEST_REVIEW_COMMENT_WRITE_USER_TITLE", "Update or delete pull request review comment"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The action to perform on the review comment
+Options are:
+- 'update' - replace the body of the review comment.
+- 'delete' - delete the review comment.
+`,
+						Enum: []any{commentMethodUpdate, commentMethodDelete},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"commentId": {
+						Type:        "number",
+						Description: "The ID of the review comment",
+					},
+					"body": {
+						Type:        "string",
+						Description: "New review comment content. Required for 'update'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo", "commentId"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commentID, err := RequiredBigInt(args, "commentId")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			var body string
+			if method == commentMethodUpdate {
+				body, err = RequiredParam[string](args, "body")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			if deps.GetFlags(ctx).LockdownMode {
+				comment, resp, err := client.PullRequests.GetComment(ctx, owner, repo, commentID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get review comment", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+				result, err := checkLockdownAuthor(ctx, deps, comment.GetUser().GetLogin(), owner, repo, "review comment")
+				if result != nil || err != nil {
+					return result, nil, err
+				}
+			}
+
+			switch method {
+			case commentMethodUpdate:
+				comment, resp, err := client.PullRequests.EditComment(ctx, owner, repo, commentID, &github.PullRequestComment{Body: github.Ptr(body)})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update review comment", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return MarshalledTextResult(MinimalResponse{
+					ID:  strconv.FormatInt(comment.GetID(), 10),
+					URL: comment.GetHTMLURL(),
+				}), nil, nil
+			case commentMethodDelete:
+				resp, err := client.PullRequests.DeleteComment(ctx, owner, repo, commentID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete review comment", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return utils.NewToolResultText(fmt.Sprintf("review comment %d deleted successfully", commentID)), nil, nil
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		})
+}
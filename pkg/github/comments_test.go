@@ -0,0 +1,213 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IssueCommentWrite(t *testing.T) {
+	serverTool := IssueCommentWrite(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "issue_comment_write", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.Contains(t, schema.Properties, "body")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo", "comment_id"})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]any
+		lockdownEnabled bool
+		expectError     bool
+		expectedErrMsg  string
+		expectedText    string
+	}{
+		{
+			name: "update comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposIssuesCommentsByOwnerByRepoByCommentID: expectRequestBody(t, map[string]any{
+					"body": "Fixed typo",
+				}).andThen(mockResponse(t, http.StatusOK, &github.IssueComment{
+					ID:      github.Ptr(int64(123)),
+					Body:    github.Ptr("Fixed typo"),
+					HTMLURL: github.Ptr("https://github.com/owner/repo/issues/1#issuecomment-123"),
+				})),
+			}),
+			requestArgs:  map[string]any{"method": "update", "owner": "owner", "repo": "repo", "comment_id": float64(123), "body": "Fixed typo"},
+			expectedText: `{"id":"123","url":"https://github.com/owner/repo/issues/1#issuecomment-123"}`,
+		},
+		{
+			name:           "update comment without body",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "update", "owner": "owner", "repo": "repo", "comment_id": float64(123)},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: body",
+		},
+		{
+			name: "delete comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposIssuesCommentsByOwnerByRepoByCommentID: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}),
+			requestArgs:  map[string]any{"method": "delete", "owner": "owner", "repo": "repo", "comment_id": float64(123)},
+			expectedText: "comment 123 deleted successfully",
+		},
+		{
+			name: "delete comment of another user fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposIssuesCommentsByOwnerByRepoByCommentID: mockResponse(t, http.StatusForbidden, `{"message": "Must have admin rights to Repository."}`),
+			}),
+			requestArgs:    map[string]any{"method": "delete", "owner": "owner", "repo": "repo", "comment_id": float64(123)},
+			expectError:    true,
+			expectedErrMsg: "failed to delete comment",
+		},
+		{
+			name: "lockdown allows comments of users with push access",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentsByOwnerByRepoByCommentID: mockResponse(t, http.StatusOK, &github.IssueComment{
+					ID:   github.Ptr(int64(7)),
+					User: &github.User{Login: github.Ptr("testuser2")},
+				}),
+				DeleteReposIssuesCommentsByOwnerByRepoByCommentID: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}),
+			requestArgs:     map[string]any{"method": "delete", "owner": "owner2", "repo": "repo2", "comment_id": float64(7)},
+			lockdownEnabled: true,
+			expectedText:    "comment 7 deleted successfully",
+		},
+		{
+			name: "lockdown restricts comments of users without push access",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentsByOwnerByRepoByCommentID: mockResponse(t, http.StatusOK, &github.IssueComment{
+					ID:   github.Ptr(int64(8)),
+					User: &github.User{Login: github.Ptr("testuser")},
+				}),
+			}),
+			requestArgs:     map[string]any{"method": "update", "owner": "owner", "repo": "repo", "comment_id": float64(8), "body": "edited"},
+			lockdownEnabled: true,
+			expectError:     true,
+			expectedErrMsg:  "access to comment is restricted by lockdown mode",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:          github.NewClient(tc.mockedClient),
+				GQLClient:       defaultGQLClient,
+				RepoAccessCache: repoAccessCache,
+				Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": tc.lockdownEnabled}),
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_PullRequestReviewCommentWrite(t *testing.T) {
+	serverTool := PullRequestReviewCommentWrite(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "pull_request_review_comment_write", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo", "commentId"})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]any
+		lockdownEnabled bool
+		expectError     bool
+		expectedErrMsg  string
+		expectedText    string
+	}{
+		{
+			name: "update review comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposPullsCommentsByOwnerByRepoByCommentID: expectRequestBody(t, map[string]any{
+					"body": "Use a constant here",
+				}).andThen(mockResponse(t, http.StatusOK, &github.PullRequestComment{
+					ID:      github.Ptr(int64(456)),
+					HTMLURL: github.Ptr("https://github.com/owner/repo/pull/2#discussion_r456"),
+				})),
+			}),
+			requestArgs:  map[string]any{"method": "update", "owner": "owner", "repo": "repo", "commentId": float64(456), "body": "Use a constant here"},
+			expectedText: `{"id":"456","url":"https://github.com/owner/repo/pull/2#discussion_r456"}`,
+		},
+		{
+			name: "delete review comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposPullsCommentsByOwnerByRepoByCommentID: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}),
+			requestArgs:  map[string]any{"method": "delete", "owner": "owner", "repo": "repo", "commentId": float64(456)},
+			expectedText: "review comment 456 deleted successfully",
+		},
+		{
+			name: "lockdown restricts review comments of users without push access",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsCommentsByOwnerByRepoByCommentID: mockResponse(t, http.StatusOK, &github.PullRequestComment{
+					ID:   github.Ptr(int64(456)),
+					User: &github.User{Login: github.Ptr("testuser")},
+				}),
+			}),
+			requestArgs:     map[string]any{"method": "delete", "owner": "owner", "repo": "repo", "commentId": float64(456)},
+			lockdownEnabled: true,
+			expectError:     true,
+			expectedErrMsg:  "access to review comment is restricted by lockdown mode",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:          github.NewClient(tc.mockedClient),
+				GQLClient:       defaultGQLClient,
+				RepoAccessCache: repoAccessCache,
+				Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": tc.lockdownEnabled}),
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxConfirmationValueLength limits how much of each argument is shown in a confirmation prompt.
+const maxConfirmationValueLength = 200
+
+// sensitiveConfirmationArgs lists, per tool, the arguments whose values are never shown
+// in a confirmation prompt because they carry secrets.
+var sensitiveConfirmationArgs = map[string][]string{
+	"actions_secret_write": {"value"},
+}
+
+// ConfirmationPolicy selects the tool calls that need human confirmation before they run.
+type ConfirmationPolicy struct {
+	// Destructive requires confirmation for every tool with the destructiveHint annotation.
+	Destructive bool `mapstructure:"destructive"`
+
+	// Tools lists additional tools that require confirmation. An entry is either a
+	// tool name, such as "merge_pull_request", or a tool name and the value of its
+	// "method" argument separated by a colon, such as "label_write:delete".
+	Tools []string `mapstructure:"tools"`
+}
+
+// Enabled reports whether the policy requires confirmation for any tool.
+func (p ConfirmationPolicy) Enabled() bool {
+	return p.Destructive || len(p.Tools) > 0
+}
+
+// requiresConfirmation reports whether a call to tool with the given method argument needs confirmation.
+func (p ConfirmationPolicy) requiresConfirmation(tool *inventory.ServerTool, method string) bool {
+	if p.Destructive && tool.Tool.Annotations != nil && tool.Tool.Annotations.DestructiveHint != nil && *tool.Tool.Annotations.DestructiveHint {
+		return true
+	}
+	for _, entry := range p.Tools {
+		name, entryMethod, hasMethod := strings.Cut(strings.TrimSpace(entry), ":")
+		if name != tool.Tool.Name {
+			continue
+		}
+		if !hasMethod || strings.EqualFold(entryMethod, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfirmationMiddleware returns middleware that asks the user to confirm tool calls
+// selected by the policy with an elicitation/create request summarising the call.
+// The call only proceeds if the user accepts. Clients that do not support elicitation
+// get an error result instead, so the action never runs unconfirmed.
+//
+// Calls in dry-run mode are not confirmed since they make no changes.
+func ConfirmationMiddleware(policy ConfirmationPolicy, inv *inventory.Inventory) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, request mcp.Request) (mcp.Result, error) {
+			req, ok := request.(*mcp.CallToolRequest)
+			if !ok || method != inventory.MCPMethodToolsCall || req.Params == nil {
+				return next(ctx, method, request)
+			}
+			if _, dryRun := ghcontext.GetDryRunRecorder(ctx); dryRun {
+				return next(ctx, method, request)
+			}
+			tool, _, err := inv.FindToolByName(req.Params.Name)
+			if err != nil {
+				return next(ctx, method, request)
+			}
+
+			var args map[string]any
+			if len(req.Params.Arguments) > 0 {
+				if err := json.Unmarshal(req.Params.Arguments, &args); err != nil {
+					// Malformed arguments are reported by the tool handler itself
+					return next(ctx, method, request)
+				}
+			}
+			toolMethod, _ := args["method"].(string)
+			if !policy.requiresConfirmation(tool, toolMethod) {
+				return next(ctx, method, request)
+			}
+
+			if !clientSupportsElicitation(req) {
+				return utils.NewToolResultError(fmt.Sprintf("%s requires user confirmation, but the client does not support elicitation. Ask the user to perform this action themselves or to use a client that supports elicitation.", req.Params.Name)), nil
+			}
+
+			result, err := req.Session.Elicit(ctx, &mcp.ElicitParams{
+				Message: confirmationMessage(tool, args),
+				RequestedSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"confirm": {
+							Type:        "boolean",
+							Description: "Proceed with this action",
+						},
+					},
+				},
+			})
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to request user confirmation", err), nil
+			}
+			if result.Action != "accept" || result.Content["confirm"] != true {
+				return utils.NewToolResultError(fmt.Sprintf("The user did not confirm %s, so no action was taken.", req.Params.Name)), nil
+			}
+
+			return next(ctx, method, request)
+		}
+	}
+}
+
+// clientSupportsElicitation reports whether the MCP client that sent this request
+// declared support for form elicitation when it initialized the session.
+func clientSupportsElicitation(req *mcp.CallToolRequest) bool {
+	if req == nil || req.Session == nil {
+		return false
+	}
+	params := req.Session.InitializeParams()
+	if params == nil || params.Capabilities == nil || params.Capabilities.Elicitation == nil {
+		return false
+	}
+	// Clients that declare neither mode support form elicitation
+	caps := params.Capabilities.Elicitation
+	return caps.Form != nil || caps.URL == nil
+}
+
+// confirmationMessage summarises a tool call for the user.
+func confirmationMessage(tool *inventory.ServerTool, args map[string]any) string {
+	title := tool.Tool.Name
+	if tool.Tool.Annotations != nil && tool.Tool.Annotations.Title != "" {
+		title = fmt.Sprintf("%s (%s)", tool.Tool.Annotations.Title, tool.Tool.Name)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Confirm: %s", title)
+
+	keys := make([]string, 0, len(args))
+	for k := range args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if slices.Contains(sensitiveConfirmationArgs[tool.Tool.Name], k) {
+			fmt.Fprintf(&b, "\n%s: [redacted]", k)
+			continue
+		}
+		value, isString := args[k].(string)
+		if !isString {
+			data, _ := json.Marshal(args[k])
+			value = string(data)
+		}
+		if runes := []rune(value); len(runes) > maxConfirmationValueLength {
+			value = string(runes[:maxConfirmationValueLength]) + "…"
+		}
+		fmt.Fprintf(&b, "\n%s: %s", k, value)
+	}
+	return b.String()
+}
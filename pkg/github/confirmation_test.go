@@ -0,0 +1,121 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connectConfirmationClient starts a server whose delete_file, label_write and
+// actions_secret_write tools report that they ran, guarded by the confirmation
+// policy, and connects a client that answers elicitation requests with elicit, if set.
+func connectConfirmationClient(t *testing.T, policy ConfirmationPolicy, elicit func(context.Context, *mcp.ElicitRequest) (*mcp.ElicitResult, error)) *mcp.ClientSession {
+	t.Helper()
+
+	inv, err := NewInventory(translations.NullTranslationHelper).WithToolsets([]string{"all"}).Build()
+	require.NoError(t, err)
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0.0.1"}, nil)
+	for _, name := range []string{"delete_file", "label_write", "actions_secret_write"} {
+		server.AddTool(&mcp.Tool{Name: name, InputSchema: &jsonschema.Schema{Type: "object"}},
+			func(context.Context, *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "done"}}}, nil
+			})
+	}
+	server.AddReceivingMiddleware(ConfirmationMiddleware(policy, inv))
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(context.Background(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, &mcp.ClientOptions{
+		ElicitationHandler: elicit,
+	})
+	session, err := client.Connect(context.Background(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	return session
+}
+
+func callConfirmationTool(t *testing.T, session *mcp.ClientSession, name string, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	result, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: name, Arguments: args})
+	require.NoError(t, err)
+	return result
+}
+
+func Test_ConfirmationMiddleware(t *testing.T) {
+	deleteArgs := map[string]any{"owner": "owner", "repo": "repo", "path": "README.md", "branch": "main", "message": "Remove README"}
+
+	t.Run("accepted destructive call runs", func(t *testing.T) {
+		var message string
+		session := connectConfirmationClient(t, ConfirmationPolicy{Destructive: true}, func(_ context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			message = req.Params.Message
+			return &mcp.ElicitResult{Action: "accept", Content: map[string]any{"confirm": true}}, nil
+		})
+
+		result := callConfirmationTool(t, session, "delete_file", deleteArgs)
+		assert.False(t, result.IsError)
+		assert.Equal(t, "done", getTextResult(t, result).Text)
+		assert.Contains(t, message, "Delete file (delete_file)")
+		assert.Contains(t, message, "path: README.md")
+	})
+
+	t.Run("declined call does not run", func(t *testing.T) {
+		session := connectConfirmationClient(t, ConfirmationPolicy{Destructive: true}, func(context.Context, *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			return &mcp.ElicitResult{Action: "decline"}, nil
+		})
+
+		result := callConfirmationTool(t, session, "delete_file", deleteArgs)
+		assert.Contains(t, getErrorResult(t, result).Text, "did not confirm delete_file")
+	})
+
+	t.Run("client without elicitation is refused", func(t *testing.T) {
+		session := connectConfirmationClient(t, ConfirmationPolicy{Destructive: true}, nil)
+
+		result := callConfirmationTool(t, session, "delete_file", deleteArgs)
+		assert.Contains(t, getErrorResult(t, result).Text, "does not support elicitation")
+	})
+
+	t.Run("configured tool methods", func(t *testing.T) {
+		elicited := 0
+		session := connectConfirmationClient(t, ConfirmationPolicy{Tools: []string{"label_write:delete"}}, func(context.Context, *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			elicited++
+			return &mcp.ElicitResult{Action: "accept", Content: map[string]any{"confirm": true}}, nil
+		})
+
+		// Only the listed method needs confirmation, and destructiveHint is not checked
+		assert.False(t, callConfirmationTool(t, session, "label_write", map[string]any{"method": "create"}).IsError)
+		assert.False(t, callConfirmationTool(t, session, "delete_file", deleteArgs).IsError)
+		assert.Equal(t, 0, elicited)
+
+		assert.False(t, callConfirmationTool(t, session, "label_write", map[string]any{"method": "delete"}).IsError)
+		assert.Equal(t, 1, elicited)
+	})
+
+	t.Run("secret values are redacted", func(t *testing.T) {
+		var message string
+		session := connectConfirmationClient(t, ConfirmationPolicy{Destructive: true}, func(_ context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			message = req.Params.Message
+			return &mcp.ElicitResult{Action: "accept", Content: map[string]any{"confirm": true}}, nil
+		})
+
+		result := callConfirmationTool(t, session, "actions_secret_write", map[string]any{
+			"method": "set",
+			"owner":  "owner",
+			"repo":   "repo",
+			"name":   "DEPLOY_TOKEN",
+			"value":  "s3cr3t-token-value",
+		})
+		assert.False(t, result.IsError)
+		assert.Contains(t, message, "name: DEPLOY_TOKEN")
+		assert.Contains(t, message, "value: [redacted]")
+		assert.NotContains(t, message, "s3cr3t-token-value")
+	})
+}
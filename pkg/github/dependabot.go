@@ -184,3 +184,119 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 		},
 	)
 }
+
+// DependabotAlertWrite creates a tool to dismiss and reopen Dependabot alerts.
+func DependabotAlertWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataDependabot,
+		mcp.Tool{
+			Name:        "dependabot_alert_write",
+			Description: t("TOOL_DEPENDABOT_ALERT_WRITE_DESCRIPTION", "Dismiss or reopen a Dependabot alert in a GitHub repository."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_DEPENDABOT_ALERT_WRITE_USER_TITLE", "Dismiss or reopen Dependabot alert"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The action to perform on the alert
+Options are:
+- 'dismiss' - dismiss an open alert, with a reason and an optional comment.
+- 'reopen' - reopen a dismissed alert.
+`,
+						Enum: []any{"dismiss", "reopen"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"alertNumber": {
+						Type:        "number",
+						Description: "The number of the alert.",
+					},
+					"dismissed_reason": {
+						Type:        "string",
+						Description: "The reason for dismissing the alert. Required for 'dismiss'.",
+						Enum:        []any{"fix_started", "inaccurate", "no_bandwidth", "not_used", "tolerable_risk"},
+					},
+					"dismissed_comment": {
+						Type:        "string",
+						Description: "A comment explaining why the alert is dismissed, up to 280 characters. Only used for 'dismiss'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo", "alertNumber"},
+			},
+		},
+		[]scopes.Scope{scopes.SecurityEvents},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			alertNumber, err := RequiredInt(args, "alertNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			var state *github.DependabotAlertState
+			switch method {
+			case "dismiss":
+				reason, err := RequiredParam[string](args, "dismissed_reason")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				comment, err := OptionalParam[string](args, "dismissed_comment")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				state = &github.DependabotAlertState{
+					State:           "dismissed",
+					DismissedReason: github.Ptr(reason),
+				}
+				if comment != "" {
+					state.DismissedComment = github.Ptr(comment)
+				}
+			case "reopen":
+				state = &github.DependabotAlertState{State: "open"}
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			alert, resp, err := client.Dependabot.UpdateAlert(ctx, owner, repo, alertNumber, state)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update alert with number '%d'", alertNumber),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(alert)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal alert: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -250,3 +251,101 @@ func Test_ListDependabotAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_DependabotAlertWrite(t *testing.T) {
+	toolDef := DependabotAlertWrite(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "dependabot_alert_write", toolDef.Tool.Name)
+	assert.False(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	schema, ok := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "dismissed_reason")
+	assert.Contains(t, schema.Properties, "dismissed_comment")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo", "alertNumber"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedState  string
+		expectedErrMsg string
+	}{
+		{
+			name: "dismiss alert",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposDependabotAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state":             "dismissed",
+					"dismissed_reason":  "not_used",
+					"dismissed_comment": "Only used by the test fixtures",
+				}).andThen(mockResponse(t, http.StatusOK, &github.DependabotAlert{
+					Number:          github.Ptr(7),
+					State:           github.Ptr("dismissed"),
+					DismissedReason: github.Ptr("not_used"),
+				})),
+			}),
+			requestArgs: map[string]any{
+				"method":            "dismiss",
+				"owner":             "owner",
+				"repo":              "repo",
+				"alertNumber":       float64(7),
+				"dismissed_reason":  "not_used",
+				"dismissed_comment": "Only used by the test fixtures",
+			},
+			expectedState: "dismissed",
+		},
+		{
+			name:           "dismiss alert without reason",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "dismiss", "owner": "owner", "repo": "repo", "alertNumber": float64(7)},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: dismissed_reason",
+		},
+		{
+			name: "reopen alert",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposDependabotAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state": "open",
+				}).andThen(mockResponse(t, http.StatusOK, &github.DependabotAlert{
+					Number: github.Ptr(7),
+					State:  github.Ptr("open"),
+				})),
+			}),
+			requestArgs:   map[string]any{"method": "reopen", "owner": "owner", "repo": "repo", "alertNumber": float64(7)},
+			expectedState: "open",
+		},
+		{
+			name: "reopen fixed alert fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposDependabotAlertsByOwnerByRepoByAlertNumber: mockResponse(t, http.StatusBadRequest, `{"message": "Alert is already fixed"}`),
+			}),
+			requestArgs:    map[string]any{"method": "reopen", "owner": "owner", "repo": "repo", "alertNumber": float64(7)},
+			expectError:    true,
+			expectedErrMsg: "failed to update alert with number '7'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returnedAlert github.DependabotAlert
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedAlert))
+			assert.Equal(t, 7, returnedAlert.GetNumber())
+			assert.Equal(t, tc.expectedState, returnedAlert.GetState())
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/github/github-mcp-server/pkg/lockdown"
 	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/telemetry"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
 	gogithub "github.com/google/go-github/v82/github"
@@ -245,6 +246,13 @@ type RequestDeps struct {
 	T                 translations.TranslationHelperFunc
 	ContentWindowSize int
 
+	// ResponseCache, when set, caches REST GET responses using conditional requests.
+	// Entries are keyed by token, so the cache can be shared between users.
+	ResponseCache transport.CacheStore
+
+	// Telemetry, when set, traces GitHub API requests and records their metrics
+	Telemetry *telemetry.Telemetry
+
 	// Feature flag checker for runtime checks
 	featureChecker inventory.FeatureFlagChecker
 }
@@ -289,7 +297,18 @@ func (d *RequestDeps) GetClient(ctx context.Context) (*gogithub.Client, error) {
 	}
 
 	// Construct REST client
-	restClient := gogithub.NewClient(nil).WithAuthToken(token)
+	var restTransport http.RoundTripper = &transport.DryRunTransport{
+		Transport: &transport.RateLimitTransport{
+			Transport: d.Telemetry.Transport(http.DefaultTransport),
+		},
+	}
+	if d.ResponseCache != nil {
+		restTransport = &transport.ConditionalCacheTransport{
+			Transport: restTransport,
+			Store:     d.ResponseCache,
+		}
+	}
+	restClient := gogithub.NewClient(&http.Client{Transport: restTransport}).WithAuthToken(token)
 	restClient.UserAgent = fmt.Sprintf("github-mcp-server/%s", d.version)
 	restClient.BaseURL = baseRestURL
 	restClient.UploadURL = uploadURL
@@ -312,7 +331,11 @@ func (d *RequestDeps) GetGQLClient(ctx context.Context) (*githubv4.Client, error
 	gqlHTTPClient := &http.Client{
 		Transport: &transport.BearerAuthTransport{
 			Transport: &transport.GraphQLFeaturesTransport{
-				Transport: http.DefaultTransport,
+				Transport: &transport.DryRunTransport{
+					Transport: &transport.RateLimitTransport{
+						Transport: d.Telemetry.Transport(http.DefaultTransport),
+					},
+				},
 			},
 			Token: token,
 		},
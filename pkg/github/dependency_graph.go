@@ -0,0 +1,435 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// dependencyChange is one entry of the dependency review diff between two commits.
+// The compare endpoint is not covered by go-github.
+type dependencyChange struct {
+	ChangeType          string                    `json:"change_type"`
+	Manifest            string                    `json:"manifest"`
+	Ecosystem           string                    `json:"ecosystem"`
+	Name                string                    `json:"name"`
+	Version             string                    `json:"version"`
+	PackageURL          string                    `json:"package_url,omitempty"`
+	License             string                    `json:"license,omitempty"`
+	SourceRepositoryURL string                    `json:"source_repository_url,omitempty"`
+	Scope               string                    `json:"scope,omitempty"`
+	Vulnerabilities     []dependencyVulnerability `json:"vulnerabilities"`
+}
+
+type dependencyVulnerability struct {
+	Severity        string `json:"severity"`
+	AdvisoryGHSAID  string `json:"advisory_ghsa_id"`
+	AdvisorySummary string `json:"advisory_summary"`
+	AdvisoryURL     string `json:"advisory_url"`
+}
+
+func DependencyGraphRead(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataDependabot,
+		mcp.Tool{
+			Name:        "dependency_graph_read",
+			Description: t("TOOL_DEPENDENCY_GRAPH_READ_DESCRIPTION", "Read the dependency graph of a GitHub repository: export its SBOM, or review the dependency changes between two commits."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_DEPENDENCY_GRAPH_READ_USER_TITLE", "Read dependency graph"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The read operation to perform
+Options are:
+- 'export_sbom' - export the software bill of materials of the default branch in SPDX format.
+- 'compare' - dependency review between the base and head commits. Lists the added and removed dependencies with their licenses and known vulnerabilities.
+`,
+						Enum: []any{"export_sbom", "compare"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"base": {
+						Type:        "string",
+						Description: "Base commit SHA or ref to compare from, e.g. the base of a pull request. Required for 'compare'.",
+					},
+					"head": {
+						Type:        "string",
+						Description: "Head commit SHA or ref to compare to, e.g. the head of a pull request. Required for 'compare'.",
+					},
+					"manifest": {
+						Type:        "string",
+						Description: "Only compare the dependencies of the manifest file at this path. Only used by 'compare'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case "export_sbom":
+				sbom, resp, err := client.DependencyGraph.GetSBOM(ctx, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to export SBOM", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				r, err := json.Marshal(sbom)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to marshal SBOM: %w", err)
+				}
+				return utils.NewToolResultText(string(r)), nil, nil
+			case "compare":
+				return compareDependencies(ctx, client, args, owner, repo)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+func compareDependencies(ctx context.Context, client *github.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	base, err := RequiredParam[string](args, "base")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	head, err := RequiredParam[string](args, "head")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	manifest, err := OptionalParam[string](args, "manifest")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/dependency-graph/compare/%s...%s", owner, repo, url.PathEscape(base), url.PathEscape(head))
+	if manifest != "" {
+		path += "?" + url.Values{"name": {manifest}}.Encode()
+	}
+	req, err := client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var changes []dependencyChange
+	resp, err := client.Do(ctx, req, &changes)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to compare dependencies", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	r, err := json.Marshal(changes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal dependency changes: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+func CreateDependencySnapshot(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataDependabot,
+		mcp.Tool{
+			Name:        "create_dependency_snapshot",
+			Description: t("TOOL_CREATE_DEPENDENCY_SNAPSHOT_DESCRIPTION", "Submit a snapshot of the dependencies of a commit to the dependency graph of a GitHub repository, e.g. for dependencies that cannot be detected from manifest files."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_DEPENDENCY_SNAPSHOT_USER_TITLE", "Submit dependency snapshot"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "The commit SHA the dependencies were detected for.",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "The fully qualified ref of the commit (e.g. refs/heads/main).",
+					},
+					"job_correlator": {
+						Type:        "string",
+						Description: "Identifies the job that detected the dependencies. A new snapshot replaces earlier snapshots with the same correlator.",
+					},
+					"job_id": {
+						Type:        "string",
+						Description: "ID of the job run that detected the dependencies.",
+					},
+					"detector_name": {
+						Type:        "string",
+						Description: "Name of the dependency detector.",
+					},
+					"detector_version": {
+						Type:        "string",
+						Description: "Version of the dependency detector.",
+					},
+					"detector_url": {
+						Type:        "string",
+						Description: "URL of the dependency detector.",
+					},
+					"manifests": {
+						Type:        "array",
+						Description: "Groups of dependencies, usually one per manifest file.",
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"name": {
+									Type:        "string",
+									Description: "Name of the manifest.",
+								},
+								"file": {
+									Type:        "string",
+									Description: "Path of the manifest file in the repository.",
+								},
+								"resolved": {
+									Type:        "array",
+									Description: "Resolved dependencies of the manifest.",
+									Items: &jsonschema.Schema{
+										Type: "object",
+										Properties: map[string]*jsonschema.Schema{
+											"package_url": {
+												Type:        "string",
+												Description: "Package URL of the dependency (e.g. pkg:npm/lodash@4.17.21).",
+											},
+											"relationship": {
+												Type:        "string",
+												Description: "Whether the manifest requests the dependency directly.",
+												Enum:        []any{"direct", "indirect"},
+											},
+											"scope": {
+												Type:        "string",
+												Description: "Whether the dependency is needed at runtime or only for development.",
+												Enum:        []any{"runtime", "development"},
+											},
+											"dependencies": {
+												Type:        "array",
+												Description: "Package URLs of the dependencies of this dependency.",
+												Items: &jsonschema.Schema{
+													Type: "string",
+												},
+											},
+										},
+										Required: []string{"package_url"},
+									},
+								},
+							},
+							Required: []string{"name"},
+						},
+					},
+				},
+				Required: []string{"owner", "repo", "sha", "ref", "job_correlator", "job_id", "detector_name", "detector_version", "detector_url"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sha, err := RequiredParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := RequiredParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			correlator, err := RequiredParam[string](args, "job_correlator")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			jobID, err := RequiredParam[string](args, "job_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			detectorName, err := RequiredParam[string](args, "detector_name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			detectorVersion, err := RequiredParam[string](args, "detector_version")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			detectorURL, err := RequiredParam[string](args, "detector_url")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			manifests, err := snapshotManifestsFromArgs(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			snapshot := &github.DependencyGraphSnapshot{
+				Version: 0,
+				Sha:     github.Ptr(sha),
+				Ref:     github.Ptr(ref),
+				Job: &github.DependencyGraphSnapshotJob{
+					Correlator: github.Ptr(correlator),
+					ID:         github.Ptr(jobID),
+				},
+				Detector: &github.DependencyGraphSnapshotDetector{
+					Name:    github.Ptr(detectorName),
+					Version: github.Ptr(detectorVersion),
+					URL:     github.Ptr(detectorURL),
+				},
+				Scanned:   &github.Timestamp{Time: time.Now()},
+				Manifests: manifests,
+			}
+
+			result, resp, err := client.DependencyGraph.CreateSnapshot(ctx, owner, repo, snapshot)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create dependency snapshot", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal snapshot result: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// snapshotManifestsFromArgs converts the manifests argument into the manifests
+// of a snapshot, which the API keys by manifest name and package URL.
+func snapshotManifestsFromArgs(args map[string]any) (map[string]*github.DependencyGraphSnapshotManifest, error) {
+	raw, ok := args["manifests"]
+	if !ok {
+		return nil, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("manifests must be an array of manifests")
+	}
+
+	manifests := make(map[string]*github.DependencyGraphSnapshotManifest, len(items))
+	for i, item := range items {
+		manifestArgs, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("manifest %d must be an object", i)
+		}
+		name, err := RequiredParam[string](manifestArgs, "name")
+		if err != nil {
+			return nil, fmt.Errorf("manifest %d: %w", i, err)
+		}
+		if _, exists := manifests[name]; exists {
+			return nil, fmt.Errorf("manifest %s is listed more than once", name)
+		}
+		file, err := OptionalParam[string](manifestArgs, "file")
+		if err != nil {
+			return nil, fmt.Errorf("manifest %s: %w", name, err)
+		}
+
+		manifest := &github.DependencyGraphSnapshotManifest{
+			Name:     github.Ptr(name),
+			Resolved: map[string]*github.DependencyGraphSnapshotResolvedDependency{},
+		}
+		if file != "" {
+			manifest.File = &github.DependencyGraphSnapshotManifestFile{SourceLocation: github.Ptr(file)}
+		}
+
+		var resolved []any
+		if raw, ok := manifestArgs["resolved"]; ok {
+			if resolved, ok = raw.([]any); !ok {
+				return nil, fmt.Errorf("manifest %s: resolved must be an array of dependencies", name)
+			}
+		}
+		for j, dep := range resolved {
+			depArgs, ok := dep.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("manifest %s: dependency %d must be an object", name, j)
+			}
+			packageURL, err := RequiredParam[string](depArgs, "package_url")
+			if err != nil {
+				return nil, fmt.Errorf("manifest %s: dependency %d: %w", name, j, err)
+			}
+			relationship, err := OptionalParam[string](depArgs, "relationship")
+			if err != nil {
+				return nil, fmt.Errorf("manifest %s: dependency %s: %w", name, packageURL, err)
+			}
+			scope, err := OptionalParam[string](depArgs, "scope")
+			if err != nil {
+				return nil, fmt.Errorf("manifest %s: dependency %s: %w", name, packageURL, err)
+			}
+			dependencies, err := OptionalStringArrayParam(depArgs, "dependencies")
+			if err != nil {
+				return nil, fmt.Errorf("manifest %s: dependency %s: %w", name, packageURL, err)
+			}
+
+			resolvedDep := &github.DependencyGraphSnapshotResolvedDependency{
+				PackageURL:   github.Ptr(packageURL),
+				Dependencies: dependencies,
+			}
+			if relationship != "" {
+				resolvedDep.Relationship = github.Ptr(relationship)
+			}
+			if scope != "" {
+				resolvedDep.Scope = github.Ptr(scope)
+			}
+			manifest.Resolved[packageURL] = resolvedDep
+		}
+
+		manifests[name] = manifest
+	}
+
+	return manifests, nil
+}
@@ -0,0 +1,234 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DependencyGraphRead(t *testing.T) {
+	serverTool := DependencyGraphRead(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "dependency_graph_read", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "base")
+	assert.Contains(t, schema.Properties, "head")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "export SBOM",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDependencyGraphSbomByOwnerByRepo: mockResponse(t, http.StatusOK, &github.SBOM{
+					SBOM: &github.SBOMInfo{
+						SPDXVersion: github.Ptr("SPDX-2.3"),
+						Name:        github.Ptr("com.github.owner/repo"),
+						Packages: []*github.RepoDependencies{
+							{Name: github.Ptr("npm:lodash"), VersionInfo: github.Ptr("4.17.21"), LicenseConcluded: github.Ptr("MIT")},
+						},
+					},
+				}),
+			}),
+			requestArgs:  map[string]any{"method": "export_sbom", "owner": "owner", "repo": "repo"},
+			expectedText: `"spdxVersion":"SPDX-2.3"`,
+		},
+		{
+			name: "compare dependencies",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDependencyGraphCompareByOwnerByRepoByBasehead: expectPath(t, "/repos/owner/repo/dependency-graph/compare/abc123...def456").andThen(
+					expectQueryParams(t, map[string]string{"name": "package-lock.json"}).andThen(
+						mockResponse(t, http.StatusOK, []map[string]any{
+							{
+								"change_type": "added",
+								"manifest":    "package-lock.json",
+								"ecosystem":   "npm",
+								"name":        "lodash",
+								"version":     "4.17.20",
+								"license":     "MIT",
+								"vulnerabilities": []map[string]any{
+									{"severity": "high", "advisory_ghsa_id": "GHSA-35jh-r3h4-6jhm", "advisory_summary": "Command Injection in lodash", "advisory_url": "https://github.com/advisories/GHSA-35jh-r3h4-6jhm"},
+								},
+							},
+						}),
+					),
+				),
+			}),
+			requestArgs:  map[string]any{"method": "compare", "owner": "owner", "repo": "repo", "base": "abc123", "head": "def456", "manifest": "package-lock.json"},
+			expectedText: `"advisory_ghsa_id":"GHSA-35jh-r3h4-6jhm"`,
+		},
+		{
+			name:           "compare without head",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "compare", "owner": "owner", "repo": "repo", "base": "abc123"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: head",
+		},
+		{
+			name: "dependency graph disabled",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDependencyGraphSbomByOwnerByRepo: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			}),
+			requestArgs:    map[string]any{"method": "export_sbom", "owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "failed to export SBOM",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: github.NewClient(tc.mockedClient),
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_CreateDependencySnapshot(t *testing.T) {
+	serverTool := CreateDependencySnapshot(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "create_dependency_snapshot", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "manifests")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "sha", "ref", "job_correlator", "job_id", "detector_name", "detector_version", "detector_url"})
+
+	baseArgs := func(manifests []any) map[string]any {
+		return map[string]any{
+			"owner":            "owner",
+			"repo":             "repo",
+			"sha":              "def456",
+			"ref":              "refs/heads/main",
+			"job_correlator":   "build-deps",
+			"job_id":           "42",
+			"detector_name":    "custom-detector",
+			"detector_version": "1.0.0",
+			"detector_url":     "https://example.com/detector",
+			"manifests":        manifests,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "submit snapshot",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposDependencyGraphSnapshotsByOwnerByRepo: func(w http.ResponseWriter, r *http.Request) {
+					body, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					var snapshot github.DependencyGraphSnapshot
+					require.NoError(t, json.Unmarshal(body, &snapshot))
+
+					assert.Equal(t, "def456", snapshot.GetSha())
+					assert.Equal(t, "build-deps", snapshot.GetJob().GetCorrelator())
+					assert.Equal(t, "custom-detector", snapshot.GetDetector().GetName())
+					assert.NotNil(t, snapshot.Scanned)
+					require.Contains(t, snapshot.Manifests, "go.mod")
+					manifest := snapshot.Manifests["go.mod"]
+					assert.Equal(t, "go.mod", manifest.GetFile().GetSourceLocation())
+					require.Contains(t, manifest.Resolved, "pkg:golang/github.com/pkg/errors@v0.9.1")
+					assert.Equal(t, "direct", manifest.Resolved["pkg:golang/github.com/pkg/errors@v0.9.1"].GetRelationship())
+
+					w.WriteHeader(http.StatusCreated)
+					_, _ = w.Write([]byte(`{"id":7,"result":"SUCCESS","message":"Dependency results for the repo have been successfully updated."}`))
+				},
+			}),
+			requestArgs: baseArgs([]any{
+				map[string]any{
+					"name": "go.mod",
+					"file": "go.mod",
+					"resolved": []any{
+						map[string]any{"package_url": "pkg:golang/github.com/pkg/errors@v0.9.1", "relationship": "direct", "scope": "runtime"},
+					},
+				},
+			}),
+			expectedText: `"result":"SUCCESS"`,
+		},
+		{
+			name:         "dependency without package_url",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: baseArgs([]any{
+				map[string]any{"name": "go.mod", "resolved": []any{map[string]any{"relationship": "direct"}}},
+			}),
+			expectError:    true,
+			expectedErrMsg: "manifest go.mod: dependency 0: missing required parameter: package_url",
+		},
+		{
+			name:         "resolved is not an array",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: baseArgs([]any{
+				map[string]any{"name": "go.mod", "resolved": map[string]any{"package_url": "pkg:golang/github.com/google/go-github/v82@v82.0.0"}},
+			}),
+			expectError:    true,
+			expectedErrMsg: "manifest go.mod: resolved must be an array of dependencies",
+		},
+		{
+			name:         "duplicate manifest",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: baseArgs([]any{
+				map[string]any{"name": "go.mod"},
+				map[string]any{"name": "go.mod"},
+			}),
+			expectError:    true,
+			expectedErrMsg: "manifest go.mod is listed more than once",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: github.NewClient(tc.mockedClient),
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
@@ -0,0 +1,565 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Method constants for the deployments tools
+const (
+	deploymentsMethodListDeployments        = "list_deployments"
+	deploymentsMethodGetDeployment          = "get_deployment"
+	deploymentsMethodListDeploymentStatuses = "list_deployment_statuses"
+	deploymentsMethodGetLiveDeployments     = "get_live_deployments"
+	deploymentsMethodCreateDeployment       = "create_deployment"
+	deploymentsMethodCreateStatus           = "create_status"
+)
+
+const (
+	// liveDeploymentsScanSize is the number of most recent deployments scanned
+	// to find the environments of a repository.
+	liveDeploymentsScanSize = 100
+	// liveDeploymentsMaxChecks is the number of deployments per environment
+	// whose status is checked before giving up on finding a successful one.
+	liveDeploymentsMaxChecks = 10
+	// liveDeploymentsMaxStatusRequests is the total number of deployment status
+	// requests made across all environments.
+	liveDeploymentsMaxStatusRequests = 30
+)
+
+// DeploymentsRead returns the tool and handler for reading deployments and deployment statuses.
+func DeploymentsRead(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataDeployments,
+		mcp.Tool{
+			Name: "deployments_read",
+			Description: t("TOOL_DEPLOYMENTS_READ_DESCRIPTION", `Get information about the deployments of a repository.
+Methods:
+- list_deployments: list deployments, newest first, optionally filtered by environment, ref, sha or task.
+- get_deployment: get a deployment with its most recent statuses.
+- list_deployment_statuses: list the statuses of a deployment, newest first.
+- get_live_deployments: for each environment, or only the given one, get the SHA of the newest deployment with a successful status, and the newest deployment if it is not live yet. At most 10 deployments per environment and 30 in total have their status checked; environments where no successful deployment was found within that limit are marked incomplete, so pass environment to check a single one.
+To find out whether a commit is deployed to an environment, get the live SHA with get_live_deployments and check that the commit is an ancestor of it with list_commits (using the live SHA as sha).
+`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_DEPLOYMENTS_READ_USER_TITLE", "Get deployments and deployment statuses"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "The method to execute",
+						Enum: []any{
+							deploymentsMethodListDeployments,
+							deploymentsMethodGetDeployment,
+							deploymentsMethodListDeploymentStatuses,
+							deploymentsMethodGetLiveDeployments,
+						},
+					},
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"deployment_id": {
+						Type:        "number",
+						Description: "The ID of the deployment. Required for 'get_deployment' and 'list_deployment_statuses'.",
+					},
+					"environment": {
+						Type:        "string",
+						Description: "Environment name, e.g. 'production' or 'staging'. Used for 'list_deployments' and 'get_live_deployments'.",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Only return deployments of this branch, tag or SHA as given when they were created. Only used for 'list_deployments'.",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "Only return deployments of this commit SHA. Only used for 'list_deployments'.",
+					},
+					"task": {
+						Type:        "string",
+						Description: "Only return deployments with this task, e.g. 'deploy' or 'deploy:migrations'. Only used for 'list_deployments'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case deploymentsMethodListDeployments:
+				return listDeployments(ctx, client, args, owner, repo, pagination)
+			case deploymentsMethodGetDeployment:
+				return getDeployment(ctx, client, args, owner, repo)
+			case deploymentsMethodListDeploymentStatuses:
+				return listDeploymentStatuses(ctx, client, args, owner, repo, pagination)
+			case deploymentsMethodGetLiveDeployments:
+				return getLiveDeployments(ctx, client, args, owner, repo)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+	return tool
+}
+
+// DeploymentWrite returns the tool and handler for creating deployments and deployment statuses.
+func DeploymentWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataDeployments,
+		mcp.Tool{
+			Name: "deployment_write",
+			Description: t("TOOL_DEPLOYMENT_WRITE_DESCRIPTION", `Create deployments and report their progress.
+A deployment is a request to deploy a ref; the deployment system that picks it up reports its progress with deployment statuses.
+Methods:
+- create_deployment: create a deployment of a ref to an environment.
+- create_status: add a status to a deployment. A 'success' status makes the deployment the live one of its environment.
+`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_DEPLOYMENT_WRITE_USER_TITLE", "Create deployments and deployment statuses"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "The method to execute",
+						Enum: []any{
+							deploymentsMethodCreateDeployment,
+							deploymentsMethodCreateStatus,
+						},
+					},
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch, tag or SHA to deploy. Required for 'create_deployment'.",
+					},
+					"environment": {
+						Type:        "string",
+						Description: "For 'create_deployment', the environment to deploy to (defaults to 'production'). For 'create_status', moves the deployment to this environment.",
+					},
+					"task": {
+						Type:        "string",
+						Description: "Task to execute, e.g. 'deploy' or 'deploy:migrations' (defaults to 'deploy'). Only used for 'create_deployment'.",
+					},
+					"description": {
+						Type:        "string",
+						Description: "Short description of the deployment or status",
+					},
+					"auto_merge": {
+						Type:        "boolean",
+						Description: "Merge the default branch into ref before deploying if ref is behind it. Only used for 'create_deployment'.",
+						Default:     json.RawMessage(`false`),
+					},
+					"required_contexts": {
+						Type:        "array",
+						Description: "Status check contexts that must pass on ref before deploying. Omit to require all of them, or pass an empty array to skip the checks. Only used for 'create_deployment'.",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"payload": {
+						Type:        "object",
+						Description: "Extra information for the deployment system. Only used for 'create_deployment'.",
+					},
+					"transient_environment": {
+						Type:        "boolean",
+						Description: "Whether the environment is specific to the deployment and will no longer exist at some point, e.g. a review app. Only used for 'create_deployment'.",
+					},
+					"production_environment": {
+						Type:        "boolean",
+						Description: "Whether the environment is one that end users interact with. Only used for 'create_deployment'.",
+					},
+					"deployment_id": {
+						Type:        "number",
+						Description: "The ID of the deployment. Required for 'create_status'.",
+					},
+					"state": {
+						Type:        "string",
+						Description: "The state of the deployment. Required for 'create_status'.",
+						Enum:        []any{"queued", "pending", "in_progress", "success", "failure", "error", "inactive"},
+					},
+					"environment_url": {
+						Type:        "string",
+						Description: "URL of the deployed environment. Only used for 'create_status'.",
+					},
+					"log_url": {
+						Type:        "string",
+						Description: "URL of the deployment output. Only used for 'create_status'.",
+					},
+					"auto_inactive": {
+						Type:        "boolean",
+						Description: "Mark earlier non-transient, non-production deployments to the same environment as inactive when the state is 'success' (defaults to true). Only used for 'create_status'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.RepoDeployment},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case deploymentsMethodCreateDeployment:
+				return createDeployment(ctx, client, args, owner, repo)
+			case deploymentsMethodCreateStatus:
+				return createDeploymentStatus(ctx, client, args, owner, repo)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+	return tool
+}
+
+func listDeployments(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+	environment, err := OptionalParam[string](args, "environment")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	ref, err := OptionalParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	sha, err := OptionalParam[string](args, "sha")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	task, err := OptionalParam[string](args, "task")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	deployments, resp, err := client.Repositories.ListDeployments(ctx, owner, repo, &github.DeploymentsListOptions{
+		SHA:         sha,
+		Ref:         ref,
+		Task:        task,
+		Environment: environment,
+		ListOptions: github.ListOptions{
+			Page:    pagination.Page,
+			PerPage: pagination.PerPage,
+		},
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list deployments", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	minimalDeployments := make([]MinimalDeployment, 0, len(deployments))
+	for _, deployment := range deployments {
+		minimalDeployments = append(minimalDeployments, convertToMinimalDeployment(deployment))
+	}
+
+	return MarshalledTextResult(minimalDeployments), nil, nil
+}
+
+func getDeployment(ctx context.Context, client *github.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	deploymentID, err := RequiredBigInt(args, "deployment_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	deployment, resp, err := client.Repositories.GetDeployment(ctx, owner, repo, deploymentID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get deployment", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	statuses, resp, err := client.Repositories.ListDeploymentStatuses(ctx, owner, repo, deploymentID, &github.ListOptions{PerPage: 10})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list deployment statuses", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	result := convertToMinimalDeployment(deployment)
+	for _, status := range statuses {
+		result.Statuses = append(result.Statuses, convertToMinimalDeploymentStatus(status))
+	}
+
+	return MarshalledTextResult(result), nil, nil
+}
+
+func listDeploymentStatuses(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+	deploymentID, err := RequiredBigInt(args, "deployment_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	statuses, resp, err := client.Repositories.ListDeploymentStatuses(ctx, owner, repo, deploymentID, &github.ListOptions{
+		Page:    pagination.Page,
+		PerPage: pagination.PerPage,
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list deployment statuses", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	minimalStatuses := make([]MinimalDeploymentStatus, 0, len(statuses))
+	for _, status := range statuses {
+		minimalStatuses = append(minimalStatuses, convertToMinimalDeploymentStatus(status))
+	}
+
+	return MarshalledTextResult(minimalStatuses), nil, nil
+}
+
+// getLiveDeployments finds, for each environment, the newest deployment whose
+// latest status is "success". Deployments are returned newest first, so the
+// environments are ordered by their most recent deployment. Each status check is
+// a request, so their number is capped per environment and in total.
+func getLiveDeployments(ctx context.Context, client *github.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	environment, err := OptionalParam[string](args, "environment")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	deployments, resp, err := client.Repositories.ListDeployments(ctx, owner, repo, &github.DeploymentsListOptions{
+		Environment: environment,
+		ListOptions: github.ListOptions{PerPage: liveDeploymentsScanSize},
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list deployments", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var environments []string
+	byEnvironment := make(map[string][]*github.Deployment)
+	for _, deployment := range deployments {
+		name := deployment.GetEnvironment()
+		if _, ok := byEnvironment[name]; !ok {
+			environments = append(environments, name)
+		}
+		byEnvironment[name] = append(byEnvironment[name], deployment)
+	}
+
+	summaries := make([]MinimalLiveDeployment, 0, len(environments))
+	statusRequests := 0
+	for _, name := range environments {
+		summary := MinimalLiveDeployment{Environment: name}
+		for i, deployment := range byEnvironment[name] {
+			if i == liveDeploymentsMaxChecks || statusRequests == liveDeploymentsMaxStatusRequests {
+				if i == 0 {
+					minimalDeployment := convertToMinimalDeployment(deployment)
+					summary.Latest = &minimalDeployment
+				}
+				summary.Incomplete = true
+				break
+			}
+			statusRequests++
+			statuses, resp, err := client.Repositories.ListDeploymentStatuses(ctx, owner, repo, deployment.GetID(), &github.ListOptions{PerPage: 1})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list deployment statuses", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			minimalDeployment := convertToMinimalDeployment(deployment)
+			if len(statuses) > 0 {
+				minimalDeployment.Statuses = []MinimalDeploymentStatus{convertToMinimalDeploymentStatus(statuses[0])}
+			}
+			if len(statuses) > 0 && statuses[0].GetState() == "success" {
+				summary.Live = &minimalDeployment
+				summary.EnvironmentURL = statuses[0].GetEnvironmentURL()
+				break
+			}
+			if i == 0 {
+				summary.Latest = &minimalDeployment
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return MarshalledTextResult(summaries), nil, nil
+}
+
+func createDeployment(ctx context.Context, client *github.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	ref, err := RequiredParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	environment, err := OptionalParam[string](args, "environment")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	task, err := OptionalParam[string](args, "task")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	description, err := OptionalParam[string](args, "description")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	autoMerge, err := OptionalBoolParamWithDefault(args, "auto_merge", false)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	payload, err := OptionalParam[map[string]any](args, "payload")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	transient, hasTransient, err := OptionalParamOK[bool](args, "transient_environment")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	production, hasProduction, err := OptionalParamOK[bool](args, "production_environment")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	request := &github.DeploymentRequest{
+		Ref:       github.Ptr(ref),
+		AutoMerge: github.Ptr(autoMerge),
+	}
+	if environment != "" {
+		request.Environment = github.Ptr(environment)
+	}
+	if task != "" {
+		request.Task = github.Ptr(task)
+	}
+	if description != "" {
+		request.Description = github.Ptr(description)
+	}
+	if payload != nil {
+		request.Payload = payload
+	}
+	// An empty array skips the status checks, so it is not the same as omitting it
+	if _, ok := args["required_contexts"]; ok {
+		requiredContexts, err := OptionalStringArrayParam(args, "required_contexts")
+		if err != nil {
+			return utils.NewToolResultError(err.Error()), nil, nil
+		}
+		request.RequiredContexts = &requiredContexts
+	}
+	if hasTransient {
+		request.TransientEnvironment = github.Ptr(transient)
+	}
+	if hasProduction {
+		request.ProductionEnvironment = github.Ptr(production)
+	}
+
+	deployment, resp, err := client.Repositories.CreateDeployment(ctx, owner, repo, request)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create deployment", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToMinimalDeployment(deployment)), nil, nil
+}
+
+func createDeploymentStatus(ctx context.Context, client *github.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	deploymentID, err := RequiredBigInt(args, "deployment_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	state, err := RequiredParam[string](args, "state")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	description, err := OptionalParam[string](args, "description")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	environment, err := OptionalParam[string](args, "environment")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	environmentURL, err := OptionalParam[string](args, "environment_url")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	logURL, err := OptionalParam[string](args, "log_url")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	autoInactive, hasAutoInactive, err := OptionalParamOK[bool](args, "auto_inactive")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	request := &github.DeploymentStatusRequest{
+		State: github.Ptr(state),
+	}
+	if description != "" {
+		request.Description = github.Ptr(description)
+	}
+	if environment != "" {
+		request.Environment = github.Ptr(environment)
+	}
+	if environmentURL != "" {
+		request.EnvironmentURL = github.Ptr(environmentURL)
+	}
+	if logURL != "" {
+		request.LogURL = github.Ptr(logURL)
+	}
+	if hasAutoInactive {
+		request.AutoInactive = github.Ptr(autoInactive)
+	}
+
+	status, resp, err := client.Repositories.CreateDeploymentStatus(ctx, owner, repo, deploymentID, request)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create deployment status", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToMinimalDeploymentStatus(status)), nil, nil
+}
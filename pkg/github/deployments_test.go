@@ -0,0 +1,313 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DeploymentsRead(t *testing.T) {
+	serverTool := DeploymentsRead(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "deployments_read", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "environment")
+	assert.Contains(t, schema.Properties, "deployment_id")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo"})
+
+	// Statuses of deployments 3 (staging, failed), 2 (staging) and 1 (production)
+	statusesByDeployment := map[string]string{
+		"/repos/owner/repo/deployments/3/statuses": `[{"id": 30, "state": "failure"}]`,
+		"/repos/owner/repo/deployments/2/statuses": `[{"id": 20, "state": "success", "environment_url": "https://staging.example.com"}]`,
+		"/repos/owner/repo/deployments/1/statuses": `[{"id": 10, "state": "success"}]`,
+	}
+	deployments := `[
+		{"id": 3, "sha": "ccc", "ref": "fix", "environment": "staging", "creator": {"login": "octocat"}},
+		{"id": 2, "sha": "bbb", "ref": "main", "environment": "staging", "payload": {}},
+		{"id": 1, "sha": "aaa", "ref": "v1.0.0", "environment": "production", "payload": {"region": "eu"}}
+	]`
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedTexts  []string
+	}{
+		{
+			name: "list deployments of an environment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"environment": "production",
+					"page":        "1",
+					"per_page":    "30",
+				}).andThen(mockResponse(t, http.StatusOK, `[{"id": 1, "sha": "aaa", "ref": "v1.0.0", "environment": "production", "payload": {"region": "eu"}}]`)),
+			}),
+			requestArgs:   map[string]any{"method": "list_deployments", "owner": "owner", "repo": "repo", "environment": "production"},
+			expectedTexts: []string{`"sha":"aaa"`, `"payload":{"region":"eu"}`},
+		},
+		{
+			name: "get deployment with statuses",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsByOwnerByRepoByDeploymentID: mockResponse(t, http.StatusOK, &github.Deployment{
+					ID:          github.Ptr(int64(2)),
+					SHA:         github.Ptr("bbb"),
+					Environment: github.Ptr("staging"),
+				}),
+				GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID: mockResponse(t, http.StatusOK, `[{"id": 20, "state": "success", "creator": {"login": "bot"}}]`),
+			}),
+			requestArgs:   map[string]any{"method": "get_deployment", "owner": "owner", "repo": "repo", "deployment_id": float64(2)},
+			expectedTexts: []string{`"statuses":[{"id":20,"state":"success","creator":"bot"}]`},
+		},
+		{
+			name:           "get deployment without id",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "get_deployment", "owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: deployment_id",
+		},
+		{
+			name: "list deployment statuses",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID: mockResponse(t, http.StatusOK, `[{"id": 21, "state": "in_progress"}, {"id": 20, "state": "queued"}]`),
+			}),
+			requestArgs:   map[string]any{"method": "list_deployment_statuses", "owner": "owner", "repo": "repo", "deployment_id": float64(2)},
+			expectedTexts: []string{`[{"id":21,"state":"in_progress"},{"id":20,"state":"queued"}]`},
+		},
+		{
+			name: "live deployments per environment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"per_page": "100",
+				}).andThen(mockResponse(t, http.StatusOK, deployments)),
+				GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID: func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(statusesByDeployment[r.URL.Path]))
+				},
+			}),
+			requestArgs: map[string]any{"method": "get_live_deployments", "owner": "owner", "repo": "repo"},
+			expectedTexts: []string{
+				`{"environment":"staging","live":{"id":2,"sha":"bbb","ref":"main","environment":"staging","statuses":[{"id":20,"state":"success","environment_url":"https://staging.example.com"}]},"latest":{"id":3,"sha":"ccc","ref":"fix","environment":"staging","creator":"octocat","statuses":[{"id":30,"state":"failure"}]},"environment_url":"https://staging.example.com"}`,
+				`{"environment":"production","live":{"id":1,"sha":"aaa","ref":"v1.0.0","environment":"production","payload":{"region":"eu"},"statuses":[{"id":10,"state":"success"}]}}`,
+			},
+		},
+		{
+			name: "environment without a successful deployment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsByOwnerByRepo:                       mockResponse(t, http.StatusOK, `[{"id": 4, "sha": "ddd", "ref": "main", "environment": "qa"}]`),
+				GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID: mockResponse(t, http.StatusOK, `[]`),
+			}),
+			requestArgs:   map[string]any{"method": "get_live_deployments", "owner": "owner", "repo": "repo", "environment": "qa"},
+			expectedTexts: []string{`[{"environment":"qa","latest":{"id":4,"sha":"ddd","ref":"main","environment":"qa"}}]`},
+		},
+		{
+			name: "list deployments fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs:    map[string]any{"method": "list_deployments", "owner": "owner", "repo": "missing"},
+			expectError:    true,
+			expectedErrMsg: "failed to list deployments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			text := getTextResult(t, result).Text
+			for _, expected := range tc.expectedTexts {
+				assert.Contains(t, text, expected)
+			}
+			if len(tc.expectedTexts) > 1 {
+				// Environments are ordered by their most recent deployment
+				assert.Less(t, strings.Index(text, tc.expectedTexts[0]), strings.Index(text, tc.expectedTexts[1]))
+			}
+		})
+	}
+}
+
+func Test_GetLiveDeployments_StatusRequestLimit(t *testing.T) {
+	// Four environments with eleven failed deployments each: the first three use
+	// up the status requests, so the last one is not checked at all
+	var deployments []map[string]any
+	for i := range 44 {
+		deployments = append(deployments, map[string]any{
+			"id":          44 - i,
+			"sha":         fmt.Sprintf("sha%d", 44-i),
+			"environment": fmt.Sprintf("env%d", i/11),
+		})
+	}
+	statusRequests := 0
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposDeploymentsByOwnerByRepo: mockResponse(t, http.StatusOK, deployments),
+		GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID: func(w http.ResponseWriter, _ *http.Request) {
+			statusRequests++
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`[{"id": 1, "state": "failure"}]`))
+		},
+	})
+
+	serverTool := DeploymentsRead(translations.NullTranslationHelper)
+	deps := BaseDeps{Client: github.NewClient(mockedClient)}
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{"method": "get_live_deployments", "owner": "owner", "repo": "repo"})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+
+	var summaries []MinimalLiveDeployment
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &summaries))
+	assert.Equal(t, liveDeploymentsMaxStatusRequests, statusRequests)
+	require.Len(t, summaries, 4)
+	for _, summary := range summaries {
+		assert.True(t, summary.Incomplete, summary.Environment)
+		assert.Nil(t, summary.Live, summary.Environment)
+	}
+	// The unchecked environment still reports its newest deployment
+	require.NotNil(t, summaries[3].Latest)
+	assert.Equal(t, "sha11", summaries[3].Latest.SHA)
+	assert.Empty(t, summaries[3].Latest.Statuses)
+}
+
+func Test_DeploymentWrite(t *testing.T) {
+	serverTool := DeploymentWrite(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "deployment_write", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "required_contexts")
+	assert.Contains(t, schema.Properties, "state")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo"})
+
+	// Tokens limited to deployments can create them
+	filter := CreateToolScopeFilter([]string{"repo_deployment"})
+	visible, err := filter(context.Background(), &serverTool)
+	require.NoError(t, err)
+	assert.True(t, visible)
+	filter = CreateToolScopeFilter([]string{"public_repo"})
+	visible, err = filter(context.Background(), &serverTool)
+	require.NoError(t, err)
+	assert.False(t, visible)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "create deployment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposDeploymentsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"ref":                    "main",
+					"environment":            "staging",
+					"auto_merge":             false,
+					"required_contexts":      []any{},
+					"payload":                map[string]any{"migrate": true},
+					"production_environment": false,
+				}).andThen(mockResponse(t, http.StatusCreated, `{"id": 5, "sha": "eee", "ref": "main", "environment": "staging", "payload": {"migrate": true}}`)),
+			}),
+			requestArgs: map[string]any{
+				"method":                 "create_deployment",
+				"owner":                  "owner",
+				"repo":                   "repo",
+				"ref":                    "main",
+				"environment":            "staging",
+				"required_contexts":      []any{},
+				"payload":                map[string]any{"migrate": true},
+				"production_environment": false,
+			},
+			expectedText: `"id":5,"sha":"eee"`,
+		},
+		{
+			name:           "create deployment without ref",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "create_deployment", "owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: ref",
+		},
+		{
+			name: "create deployment blocked by failing checks",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposDeploymentsByOwnerByRepo: mockResponse(t, http.StatusConflict, `{"message": "Conflict: Commit status checks failed for main."}`),
+			}),
+			requestArgs:    map[string]any{"method": "create_deployment", "owner": "owner", "repo": "repo", "ref": "main"},
+			expectError:    true,
+			expectedErrMsg: "failed to create deployment",
+		},
+		{
+			name: "create status",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposDeploymentsStatusesByOwnerByRepoByDeploymentID: expectRequestBody(t, map[string]any{
+					"state":           "success",
+					"environment_url": "https://staging.example.com",
+					"auto_inactive":   true,
+				}).andThen(mockResponse(t, http.StatusCreated, `{"id": 50, "state": "success", "environment_url": "https://staging.example.com"}`)),
+			}),
+			requestArgs: map[string]any{
+				"method":          "create_status",
+				"owner":           "owner",
+				"repo":            "repo",
+				"deployment_id":   float64(5),
+				"state":           "success",
+				"environment_url": "https://staging.example.com",
+				"auto_inactive":   true,
+			},
+			expectedText: `"id":50,"state":"success"`,
+		},
+		{
+			name:           "create status without state",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "create_status", "owner": "owner", "repo": "repo", "deployment_id": float64(5)},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: state",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/scopes"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -123,6 +125,40 @@ func getQueryType(useOrdering bool, categoryID *githubv4.ID) any {
 	return &BasicNoOrder{}
 }
 
+// discussionRepo returns the repository argument of a discussions tool.
+// When not provided, it defaults to the .github repository, which holds
+// the discussions at the organisation level.
+func discussionRepo(args map[string]any) (string, error) {
+	repo, err := OptionalParam[string](args, "repo")
+	if err != nil {
+		return "", err
+	}
+	if repo == "" {
+		repo = ".github"
+	}
+	return repo, nil
+}
+
+// getDiscussionID returns the node ID of a discussion, which the discussion mutations take.
+func getDiscussionID(ctx context.Context, client *githubv4.Client, owner, repo string, discussionNumber int) (githubv4.ID, error) {
+	var q struct {
+		Repository struct {
+			Discussion struct {
+				ID githubv4.ID
+			} `graphql:"discussion(number: $discussionNumber)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	vars := map[string]any{
+		"owner":            githubv4.String(owner),
+		"repo":             githubv4.String(repo),
+		"discussionNumber": githubv4.Int(discussionNumber), // #nosec G115 - discussion numbers are always small positive integers
+	}
+	if err := client.Query(ctx, &q, vars); err != nil {
+		return "", err
+	}
+	return q.Repository.Discussion.ID, nil
+}
+
 func ListDiscussions(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
 		ToolsetMetadataDiscussions,
@@ -168,15 +204,10 @@ func ListDiscussions(t translations.TranslationHelperFunc) inventory.ServerTool
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			repo, err := OptionalParam[string](args, "repo")
+			repo, err := discussionRepo(args)
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			// when not provided, default to the .github repository
-			// this will query discussions at the organisation level
-			if repo == "" {
-				repo = ".github"
-			}
 
 			category, err := OptionalParam[string](args, "category")
 			if err != nil {
@@ -452,7 +483,11 @@ func GetDiscussionComments(t translations.TranslationHelperFunc) inventory.Serve
 					Discussion struct {
 						Comments struct {
 							Nodes []struct {
-								Body githubv4.String
+								ID     githubv4.ID
+								Body   githubv4.String
+								Author struct {
+									Login githubv4.String
+								}
 							}
 							PageInfo struct {
 								HasNextPage     githubv4.Boolean
@@ -482,7 +517,14 @@ func GetDiscussionComments(t translations.TranslationHelperFunc) inventory.Serve
 
 			var comments []*github.IssueComment
 			for _, c := range q.Repository.Discussion.Comments.Nodes {
-				comments = append(comments, &github.IssueComment{Body: github.Ptr(string(c.Body))})
+				comment := &github.IssueComment{
+					NodeID: github.Ptr(fmt.Sprint(c.ID)),
+					Body:   github.Ptr(string(c.Body)),
+				}
+				if c.Author.Login != "" {
+					comment.User = &github.User{Login: github.Ptr(string(c.Author.Login))}
+				}
+				comments = append(comments, comment)
 			}
 
 			// Create response with pagination info
@@ -538,15 +580,10 @@ func ListDiscussionCategories(t translations.TranslationHelperFunc) inventory.Se
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			repo, err := OptionalParam[string](args, "repo")
+			repo, err := discussionRepo(args)
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			// when not provided, default to the .github repository
-			// this will query discussion categories at the organisation level
-			if repo == "" {
-				repo = ".github"
-			}
 
 			client, err := deps.GetGQLClient(ctx)
 			if err != nil {
@@ -607,3 +644,533 @@ func ListDiscussionCategories(t translations.TranslationHelperFunc) inventory.Se
 		},
 	)
 }
+
+// discussionCommentQuery fetches the author of a discussion comment and the repository
+// of its discussion.
+type discussionCommentQuery struct {
+	Node struct {
+		Typename          githubv4.String `graphql:"__typename"`
+		DiscussionComment struct {
+			Author struct {
+				Login githubv4.String
+			}
+			Discussion struct {
+				Repository struct {
+					Name  githubv4.String
+					Owner struct {
+						Login githubv4.String
+					}
+				}
+			}
+		} `graphql:"... on DiscussionComment"`
+	} `graphql:"node(id: $id)"`
+}
+
+// checkDiscussionCommentLockdown applies lockdown mode to a discussion comment looked up
+// by node ID. The comment must belong to a discussion of owner/repo, and comments whose
+// author is unknown are rejected rather than let through.
+func checkDiscussionCommentLockdown(ctx context.Context, deps ToolDependencies, client *githubv4.Client, commentID, owner, repo string) (*mcp.CallToolResult, error) {
+	var q discussionCommentQuery
+	if err := client.Query(ctx, &q, map[string]any{"id": githubv4.ID(commentID)}); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get discussion comment", err), nil
+	}
+	if q.Node.Typename != "DiscussionComment" {
+		return utils.NewToolResultError(fmt.Sprintf("%s is not a discussion comment", commentID)), nil
+	}
+	comment := q.Node.DiscussionComment
+	if !strings.EqualFold(string(comment.Discussion.Repository.Owner.Login), owner) || !strings.EqualFold(string(comment.Discussion.Repository.Name), repo) {
+		return utils.NewToolResultError(fmt.Sprintf("discussion comment %s does not belong to %s/%s", commentID, owner, repo)), nil
+	}
+	if comment.Author.Login == "" {
+		return utils.NewToolResultError("access to discussion comment is restricted by lockdown mode"), nil
+	}
+	return checkLockdownAuthor(ctx, deps, string(comment.Author.Login), owner, repo, "discussion comment")
+}
+
+// DiscussionCommentWrite creates a tool to add, reply to, update, delete and mark discussion comments as the answer.
+func DiscussionCommentWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataDiscussions,
+		mcp.Tool{
+			Name:        "discussion_comment_write",
+			Description: t("TOOL_DISCUSSION_COMMENT_WRITE_DESCRIPTION", "Add a comment or a threaded reply to a discussion, update or delete a comment, or mark a comment as the answer of a Q&A discussion"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_DISCUSSION_COMMENT_WRITE_USER_TITLE", "Write discussion comment"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The action to perform on the comment
+Options are:
+- 'add' - add a top level comment to the discussion given by discussionNumber.
+- 'reply' - reply to the top level comment given by commentId, in the discussion given by discussionNumber.
+- 'update' - replace the body of the comment.
+- 'delete' - delete the comment.
+- 'mark_answer' - mark the comment as the answer of a discussion in a Q&A category.
+- 'unmark_answer' - unmark the comment as the answer.
+`,
+						Enum: []any{"add", "reply", commentMethodUpdate, commentMethodDelete, "mark_answer", "unmark_answer"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name. If not provided, the discussion is in the organisation level discussions.",
+					},
+					"discussionNumber": {
+						Type:        "number",
+						Description: "Discussion Number. Required for 'add' and 'reply'.",
+					},
+					"commentId": {
+						Type:        "string",
+						Description: "The node ID of the comment, as returned by get_discussion_comments. Required for all methods except 'add'.",
+					},
+					"body": {
+						Type:        "string",
+						Description: "Comment content. Required for 'add', 'reply' and 'update'.",
+					},
+				},
+				Required: []string{"method", "owner"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := discussionRepo(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			var discussionNumber int
+			if method == "add" || method == "reply" {
+				discussionNumber, err = RequiredInt(args, "discussionNumber")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+			var commentID string
+			if method != "add" {
+				commentID, err = RequiredParam[string](args, "commentId")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+			var body string
+			if method == "add" || method == "reply" || method == commentMethodUpdate {
+				body, err = RequiredParam[string](args, "body")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil, nil
+			}
+
+			if commentID != "" && deps.GetFlags(ctx).LockdownMode {
+				result, err := checkDiscussionCommentLockdown(ctx, deps, client, commentID, owner, repo)
+				if result != nil || err != nil {
+					return result, nil, err
+				}
+			}
+
+			switch method {
+			case "add", "reply":
+				discussionID, err := getDiscussionID(ctx, client, owner, repo, discussionNumber)
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get discussion", err), nil, nil
+				}
+				var mutation struct {
+					AddDiscussionComment struct {
+						Comment struct {
+							ID  githubv4.ID
+							URL githubv4.String `graphql:"url"`
+						}
+					} `graphql:"addDiscussionComment(input: $input)"`
+				}
+				input := githubv4.AddDiscussionCommentInput{
+					DiscussionID: discussionID,
+					Body:         githubv4.String(body),
+				}
+				if method == "reply" {
+					input.ReplyToID = githubv4.NewID(commentID)
+				}
+				if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to add discussion comment", err), nil, nil
+				}
+				return MarshalledTextResult(MinimalResponse{
+					ID:  fmt.Sprint(mutation.AddDiscussionComment.Comment.ID),
+					URL: string(mutation.AddDiscussionComment.Comment.URL),
+				}), nil, nil
+			case commentMethodUpdate:
+				var mutation struct {
+					UpdateDiscussionComment struct {
+						Comment struct {
+							ID  githubv4.ID
+							URL githubv4.String `graphql:"url"`
+						}
+					} `graphql:"updateDiscussionComment(input: $input)"`
+				}
+				input := githubv4.UpdateDiscussionCommentInput{
+					CommentID: githubv4.ID(commentID),
+					Body:      githubv4.String(body),
+				}
+				if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update discussion comment", err), nil, nil
+				}
+				return MarshalledTextResult(MinimalResponse{
+					ID:  fmt.Sprint(mutation.UpdateDiscussionComment.Comment.ID),
+					URL: string(mutation.UpdateDiscussionComment.Comment.URL),
+				}), nil, nil
+			case commentMethodDelete:
+				var mutation struct {
+					DeleteDiscussionComment struct {
+						Comment struct {
+							ID githubv4.ID
+						}
+					} `graphql:"deleteDiscussionComment(input: $input)"`
+				}
+				input := githubv4.DeleteDiscussionCommentInput{
+					ID: githubv4.ID(commentID),
+				}
+				if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to delete discussion comment", err), nil, nil
+				}
+				return utils.NewToolResultText(fmt.Sprintf("discussion comment %s deleted successfully", commentID)), nil, nil
+			case "mark_answer":
+				var mutation struct {
+					MarkDiscussionCommentAsAnswer struct {
+						Discussion struct {
+							ID  githubv4.ID
+							URL githubv4.String `graphql:"url"`
+						}
+					} `graphql:"markDiscussionCommentAsAnswer(input: $input)"`
+				}
+				input := githubv4.MarkDiscussionCommentAsAnswerInput{
+					ID: githubv4.ID(commentID),
+				}
+				if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to mark discussion comment as answer", err), nil, nil
+				}
+				return utils.NewToolResultText(fmt.Sprintf("discussion comment %s marked as the answer of %s", commentID, mutation.MarkDiscussionCommentAsAnswer.Discussion.URL)), nil, nil
+			case "unmark_answer":
+				var mutation struct {
+					UnmarkDiscussionCommentAsAnswer struct {
+						Discussion struct {
+							ID  githubv4.ID
+							URL githubv4.String `graphql:"url"`
+						}
+					} `graphql:"unmarkDiscussionCommentAsAnswer(input: $input)"`
+				}
+				input := githubv4.UnmarkDiscussionCommentAsAnswerInput{
+					ID: githubv4.ID(commentID),
+				}
+				if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to unmark discussion comment as answer", err), nil, nil
+				}
+				return utils.NewToolResultText(fmt.Sprintf("discussion comment %s unmarked as the answer of %s", commentID, mutation.UnmarkDiscussionCommentAsAnswer.Discussion.URL)), nil, nil
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+// DiscussionWrite creates a tool to create, update, close, reopen, lock and unlock discussions.
+func DiscussionWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataDiscussions,
+		mcp.Tool{
+			Name:        "discussion_write",
+			Description: t("TOOL_DISCUSSION_WRITE_DESCRIPTION", "Create a discussion in a repository or organisation, or update, close, reopen, lock or unlock an existing discussion. To comment on a discussion, use discussion_comment_write."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_DISCUSSION_WRITE_USER_TITLE", "Write discussion"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The action to perform
+Options are:
+- 'create' - create a discussion in a category.
+- 'update' - change the title, body or category of a discussion.
+- 'close' - close a discussion, with an optional reason.
+- 'reopen' - reopen a closed discussion.
+- 'lock' - lock a discussion, so that only collaborators can comment.
+- 'unlock' - unlock a discussion.
+`,
+						Enum: []any{"create", "update", "close", "reopen", "lock", "unlock"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name. If not provided, the discussion is in the organisation level discussions.",
+					},
+					"discussionNumber": {
+						Type:        "number",
+						Description: "Discussion Number. Required for all methods except 'create'.",
+					},
+					"category": {
+						Type:        "string",
+						Description: "Discussion category ID, as returned by list_discussion_categories. Required for 'create'.",
+					},
+					"title": {
+						Type:        "string",
+						Description: "Discussion title. Required for 'create'.",
+					},
+					"body": {
+						Type:        "string",
+						Description: "Discussion body. Required for 'create'.",
+					},
+					"closeReason": {
+						Type:        "string",
+						Description: "Reason for closing the discussion. Only used for 'close'.",
+						Enum:        []any{"RESOLVED", "OUTDATED", "DUPLICATE"},
+					},
+					"lockReason": {
+						Type:        "string",
+						Description: "Reason for locking the discussion. Only used for 'lock'.",
+						Enum:        []any{"OFF_TOPIC", "TOO_HEATED", "RESOLVED", "SPAM"},
+					},
+				},
+				Required: []string{"method", "owner"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := discussionRepo(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil, nil
+			}
+
+			if method == "create" {
+				return createDiscussion(ctx, client, args, owner, repo)
+			}
+
+			discussionNumber, err := RequiredInt(args, "discussionNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			discussionID, err := getDiscussionID(ctx, client, owner, repo, discussionNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get discussion", err), nil, nil
+			}
+
+			switch method {
+			case "update":
+				return updateDiscussion(ctx, client, args, discussionID)
+			case "close":
+				closeReason, err := OptionalParam[string](args, "closeReason")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				var mutation struct {
+					CloseDiscussion struct {
+						Discussion struct {
+							ID     githubv4.ID
+							Number githubv4.Int
+							URL    githubv4.String `graphql:"url"`
+						}
+					} `graphql:"closeDiscussion(input: $input)"`
+				}
+				input := githubv4.CloseDiscussionInput{
+					DiscussionID: discussionID,
+				}
+				if closeReason != "" {
+					reason := githubv4.DiscussionCloseReason(closeReason)
+					input.Reason = &reason
+				}
+				if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to close discussion", err), nil, nil
+				}
+				return MarshalledTextResult(MinimalResponse{
+					ID:  fmt.Sprint(mutation.CloseDiscussion.Discussion.Number),
+					URL: string(mutation.CloseDiscussion.Discussion.URL),
+				}), nil, nil
+			case "reopen":
+				var mutation struct {
+					ReopenDiscussion struct {
+						Discussion struct {
+							ID     githubv4.ID
+							Number githubv4.Int
+							URL    githubv4.String `graphql:"url"`
+						}
+					} `graphql:"reopenDiscussion(input: $input)"`
+				}
+				input := githubv4.ReopenDiscussionInput{
+					DiscussionID: discussionID,
+				}
+				if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to reopen discussion", err), nil, nil
+				}
+				return MarshalledTextResult(MinimalResponse{
+					ID:  fmt.Sprint(mutation.ReopenDiscussion.Discussion.Number),
+					URL: string(mutation.ReopenDiscussion.Discussion.URL),
+				}), nil, nil
+			case "lock":
+				lockReason, err := OptionalParam[string](args, "lockReason")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				var mutation struct {
+					LockLockable struct {
+						LockedRecord struct {
+							Locked githubv4.Boolean
+						}
+					} `graphql:"lockLockable(input: $input)"`
+				}
+				input := githubv4.LockLockableInput{
+					LockableID: discussionID,
+				}
+				if lockReason != "" {
+					reason := githubv4.LockReason(lockReason)
+					input.LockReason = &reason
+				}
+				if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to lock discussion", err), nil, nil
+				}
+				return utils.NewToolResultText(fmt.Sprintf("discussion #%d locked successfully", discussionNumber)), nil, nil
+			case "unlock":
+				var mutation struct {
+					UnlockLockable struct {
+						UnlockedRecord struct {
+							Locked githubv4.Boolean
+						}
+					} `graphql:"unlockLockable(input: $input)"`
+				}
+				input := githubv4.UnlockLockableInput{
+					LockableID: discussionID,
+				}
+				if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to unlock discussion", err), nil, nil
+				}
+				return utils.NewToolResultText(fmt.Sprintf("discussion #%d unlocked successfully", discussionNumber)), nil, nil
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+func createDiscussion(ctx context.Context, client *githubv4.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	category, err := RequiredParam[string](args, "category")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	title, err := RequiredParam[string](args, "title")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	body, err := RequiredParam[string](args, "body")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	repoID, err := getRepositoryID(ctx, client, owner, repo)
+	if err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get repository", err), nil, nil
+	}
+
+	var mutation struct {
+		CreateDiscussion struct {
+			Discussion struct {
+				ID     githubv4.ID
+				Number githubv4.Int
+				URL    githubv4.String `graphql:"url"`
+			}
+		} `graphql:"createDiscussion(input: $input)"`
+	}
+	input := githubv4.CreateDiscussionInput{
+		RepositoryID: repoID,
+		CategoryID:   githubv4.ID(category),
+		Title:        githubv4.String(title),
+		Body:         githubv4.String(body),
+	}
+	if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to create discussion", err), nil, nil
+	}
+
+	return MarshalledTextResult(MinimalResponse{
+		ID:  fmt.Sprint(mutation.CreateDiscussion.Discussion.Number),
+		URL: string(mutation.CreateDiscussion.Discussion.URL),
+	}), nil, nil
+}
+
+func updateDiscussion(ctx context.Context, client *githubv4.Client, args map[string]any, discussionID githubv4.ID) (*mcp.CallToolResult, any, error) {
+	input := githubv4.UpdateDiscussionInput{
+		DiscussionID: discussionID,
+	}
+	title, err := OptionalParam[string](args, "title")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if title != "" {
+		input.Title = githubv4.NewString(githubv4.String(title))
+	}
+	body, ok, err := OptionalParamOK[string](args, "body")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if ok {
+		input.Body = githubv4.NewString(githubv4.String(body))
+	}
+	category, err := OptionalParam[string](args, "category")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if category != "" {
+		input.CategoryID = githubv4.NewID(category)
+	}
+	if input.Title == nil && input.Body == nil && input.CategoryID == nil {
+		return utils.NewToolResultError("at least one of title, body or category must be provided for update"), nil, nil
+	}
+
+	var mutation struct {
+		UpdateDiscussion struct {
+			Discussion struct {
+				ID     githubv4.ID
+				Number githubv4.Int
+				URL    githubv4.String `graphql:"url"`
+			}
+		} `graphql:"updateDiscussion(input: $input)"`
+	}
+	if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update discussion", err), nil, nil
+	}
+
+	return MarshalledTextResult(MinimalResponse{
+		ID:  fmt.Sprint(mutation.UpdateDiscussion.Discussion.Number),
+		URL: string(mutation.UpdateDiscussion.Discussion.URL),
+	}), nil, nil
+}
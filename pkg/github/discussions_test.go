@@ -606,7 +606,7 @@ func Test_GetDiscussionComments(t *testing.T) {
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "discussionNumber"})
 
 	// Use exact string query that matches implementation output
-	qGetComments := "query($after:String$discussionNumber:Int!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){comments(first: $first, after: $after){nodes{body},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}}"
+	qGetComments := "query($after:String$discussionNumber:Int!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){comments(first: $first, after: $after){nodes{id,body,author{login}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}}"
 
 	// Variables matching what GraphQL receives after JSON marshaling/unmarshaling
 	vars := map[string]any{
@@ -622,8 +622,8 @@ func Test_GetDiscussionComments(t *testing.T) {
 			"discussion": map[string]any{
 				"comments": map[string]any{
 					"nodes": []map[string]any{
-						{"body": "This is the first comment"},
-						{"body": "This is the second comment"},
+						{"id": "DC_kwDOA1", "body": "This is the first comment", "author": map[string]any{"login": "octocat"}},
+						{"id": "DC_kwDOA2", "body": "This is the second comment", "author": map[string]any{"login": "hubot"}},
 					},
 					"pageInfo": map[string]any{
 						"hasNextPage":     false,
@@ -670,8 +670,12 @@ func Test_GetDiscussionComments(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, response.Comments, 2)
 	expectedBodies := []string{"This is the first comment", "This is the second comment"}
+	expectedIDs := []string{"DC_kwDOA1", "DC_kwDOA2"}
+	expectedAuthors := []string{"octocat", "hubot"}
 	for i, comment := range response.Comments {
 		assert.Equal(t, expectedBodies[i], *comment.Body)
+		assert.Equal(t, expectedIDs[i], comment.GetNodeID())
+		assert.Equal(t, expectedAuthors[i], comment.GetUser().GetLogin())
 	}
 }
 
@@ -819,3 +823,578 @@ func Test_ListDiscussionCategories(t *testing.T) {
 		})
 	}
 }
+
+var (
+	discussionIDQuery = struct {
+		Repository struct {
+			Discussion struct {
+				ID githubv4.ID
+			} `graphql:"discussion(number: $discussionNumber)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}{}
+	discussionIDVars = map[string]any{
+		"owner":            githubv4.String("owner"),
+		"repo":             githubv4.String("repo"),
+		"discussionNumber": githubv4.Int(1),
+	}
+	discussionIDResponse = githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"discussion": map[string]any{"id": "D_kwDOA1"},
+		},
+	})
+)
+
+func Test_DiscussionCommentWrite(t *testing.T) {
+	toolDef := DiscussionCommentWrite(translations.NullTranslationHelper)
+	tool := toolDef.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "discussion_comment_write", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "discussionNumber")
+	assert.Contains(t, schema.Properties, "commentId")
+	assert.Contains(t, schema.Properties, "body")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner"})
+
+	updateMutation := struct {
+		UpdateDiscussionComment struct {
+			Comment struct {
+				ID  githubv4.ID
+				URL githubv4.String `graphql:"url"`
+			}
+		} `graphql:"updateDiscussionComment(input: $input)"`
+	}{}
+	deleteMutation := struct {
+		DeleteDiscussionComment struct {
+			Comment struct {
+				ID githubv4.ID
+			}
+		} `graphql:"deleteDiscussionComment(input: $input)"`
+	}{}
+	addMutation := struct {
+		AddDiscussionComment struct {
+			Comment struct {
+				ID  githubv4.ID
+				URL githubv4.String `graphql:"url"`
+			}
+		} `graphql:"addDiscussionComment(input: $input)"`
+	}{}
+	addResponse := githubv4mock.DataResponse(map[string]any{
+		"addDiscussionComment": map[string]any{
+			"comment": map[string]any{
+				"id":  "DC_kwDOA3",
+				"url": "https://github.com/owner/repo/discussions/1#discussioncomment-3",
+			},
+		},
+	})
+	discussionIDMatcher := githubv4mock.NewQueryMatcher(discussionIDQuery, discussionIDVars, discussionIDResponse)
+	orgDiscussionIDMatcher := githubv4mock.NewQueryMatcher(discussionIDQuery, map[string]any{
+		"owner":            githubv4.String("owner"),
+		"repo":             githubv4.String(".github"),
+		"discussionNumber": githubv4.Int(1),
+	}, discussionIDResponse)
+	commentResponse := func(typename, login, owner, repo string) githubv4mock.GQLResponse {
+		return githubv4mock.DataResponse(map[string]any{
+			"node": map[string]any{
+				"__typename": typename,
+				"author":     map[string]any{"login": login},
+				"discussion": map[string]any{
+					"repository": map[string]any{"name": repo, "owner": map[string]any{"login": owner}},
+				},
+			},
+		})
+	}
+
+	tests := []struct {
+		name            string
+		matchers        []githubv4mock.Matcher
+		requestArgs     map[string]any
+		lockdownEnabled bool
+		expectError     bool
+		expectedErrMsg  string
+		expectedText    string
+	}{
+		{
+			name: "update discussion comment",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewMutationMatcher(
+					updateMutation,
+					githubv4.UpdateDiscussionCommentInput{
+						CommentID: githubv4.ID("DC_kwDOA1"),
+						Body:      githubv4.String("Updated answer"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"updateDiscussionComment": map[string]any{
+							"comment": map[string]any{
+								"id":  "DC_kwDOA1",
+								"url": "https://github.com/owner/repo/discussions/1#discussioncomment-1",
+							},
+						},
+					}),
+				),
+			},
+			requestArgs:  map[string]any{"method": "update", "owner": "owner", "repo": "repo", "commentId": "DC_kwDOA1", "body": "Updated answer"},
+			expectedText: `{"id":"DC_kwDOA1","url":"https://github.com/owner/repo/discussions/1#discussioncomment-1"}`,
+		},
+		{
+			name: "add discussion comment",
+			matchers: []githubv4mock.Matcher{
+				discussionIDMatcher,
+				githubv4mock.NewMutationMatcher(
+					addMutation,
+					githubv4.AddDiscussionCommentInput{
+						DiscussionID: githubv4.ID("D_kwDOA1"),
+						Body:         githubv4.String("Thanks for the report"),
+					},
+					nil,
+					addResponse,
+				),
+			},
+			requestArgs:  map[string]any{"method": "add", "owner": "owner", "repo": "repo", "discussionNumber": float64(1), "body": "Thanks for the report"},
+			expectedText: `{"id":"DC_kwDOA3","url":"https://github.com/owner/repo/discussions/1#discussioncomment-3"}`,
+		},
+		{
+			name: "reply to discussion comment",
+			matchers: []githubv4mock.Matcher{
+				discussionIDMatcher,
+				githubv4mock.NewMutationMatcher(
+					addMutation,
+					githubv4.AddDiscussionCommentInput{
+						DiscussionID: githubv4.ID("D_kwDOA1"),
+						Body:         githubv4.String("Does this still happen on the latest release?"),
+						ReplyToID:    githubv4.NewID("DC_kwDOA1"),
+					},
+					nil,
+					addResponse,
+				),
+			},
+			requestArgs:  map[string]any{"method": "reply", "owner": "owner", "repo": "repo", "discussionNumber": float64(1), "commentId": "DC_kwDOA1", "body": "Does this still happen on the latest release?"},
+			expectedText: `{"id":"DC_kwDOA3","url":"https://github.com/owner/repo/discussions/1#discussioncomment-3"}`,
+		},
+		{
+			name: "add comment to organisation discussion",
+			matchers: []githubv4mock.Matcher{
+				orgDiscussionIDMatcher,
+				githubv4mock.NewMutationMatcher(
+					addMutation,
+					githubv4.AddDiscussionCommentInput{
+						DiscussionID: githubv4.ID("D_kwDOA1"),
+						Body:         githubv4.String("Thanks for the report"),
+					},
+					nil,
+					addResponse,
+				),
+			},
+			requestArgs:  map[string]any{"method": "add", "owner": "owner", "discussionNumber": float64(1), "body": "Thanks for the report"},
+			expectedText: `{"id":"DC_kwDOA3","url":"https://github.com/owner/repo/discussions/1#discussioncomment-3"}`,
+		},
+		{
+			name: "reply to organisation discussion comment",
+			matchers: []githubv4mock.Matcher{
+				orgDiscussionIDMatcher,
+				githubv4mock.NewMutationMatcher(
+					addMutation,
+					githubv4.AddDiscussionCommentInput{
+						DiscussionID: githubv4.ID("D_kwDOA1"),
+						Body:         githubv4.String("Does this still happen on the latest release?"),
+						ReplyToID:    githubv4.NewID("DC_kwDOA1"),
+					},
+					nil,
+					addResponse,
+				),
+			},
+			requestArgs:  map[string]any{"method": "reply", "owner": "owner", "discussionNumber": float64(1), "commentId": "DC_kwDOA1", "body": "Does this still happen on the latest release?"},
+			expectedText: `{"id":"DC_kwDOA3","url":"https://github.com/owner/repo/discussions/1#discussioncomment-3"}`,
+		},
+		{
+			name:           "reply without commentId",
+			requestArgs:    map[string]any{"method": "reply", "owner": "owner", "repo": "repo", "discussionNumber": float64(1), "body": "reply"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: commentId",
+		},
+		{
+			name:           "add without discussionNumber",
+			requestArgs:    map[string]any{"method": "add", "owner": "owner", "repo": "repo", "body": "comment"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: discussionNumber",
+		},
+		{
+			name: "mark discussion comment as answer",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewMutationMatcher(
+					struct {
+						MarkDiscussionCommentAsAnswer struct {
+							Discussion struct {
+								ID  githubv4.ID
+								URL githubv4.String `graphql:"url"`
+							}
+						} `graphql:"markDiscussionCommentAsAnswer(input: $input)"`
+					}{},
+					githubv4.MarkDiscussionCommentAsAnswerInput{
+						ID: githubv4.ID("DC_kwDOA1"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"markDiscussionCommentAsAnswer": map[string]any{
+							"discussion": map[string]any{
+								"id":  "D_kwDOA1",
+								"url": "https://github.com/owner/repo/discussions/1",
+							},
+						},
+					}),
+				),
+			},
+			requestArgs:  map[string]any{"method": "mark_answer", "owner": "owner", "repo": "repo", "commentId": "DC_kwDOA1"},
+			expectedText: "discussion comment DC_kwDOA1 marked as the answer of https://github.com/owner/repo/discussions/1",
+		},
+		{
+			name:           "update discussion comment without body",
+			requestArgs:    map[string]any{"method": "update", "owner": "owner", "repo": "repo", "commentId": "DC_kwDOA1"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: body",
+		},
+		{
+			name: "delete discussion comment",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewMutationMatcher(
+					deleteMutation,
+					githubv4.DeleteDiscussionCommentInput{
+						ID: githubv4.ID("DC_kwDOA1"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"deleteDiscussionComment": map[string]any{
+							"comment": map[string]any{"id": "DC_kwDOA1"},
+						},
+					}),
+				),
+			},
+			requestArgs:  map[string]any{"method": "delete", "owner": "owner", "repo": "repo", "commentId": "DC_kwDOA1"},
+			expectedText: "discussion comment DC_kwDOA1 deleted successfully",
+		},
+		{
+			name: "delete discussion comment fails",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewMutationMatcher(
+					deleteMutation,
+					githubv4.DeleteDiscussionCommentInput{
+						ID: githubv4.ID("DC_missing"),
+					},
+					nil,
+					githubv4mock.ErrorResponse("Could not resolve to a node with the global id of 'DC_missing'"),
+				),
+			},
+			requestArgs:    map[string]any{"method": "delete", "owner": "owner", "repo": "repo", "commentId": "DC_missing"},
+			expectError:    true,
+			expectedErrMsg: "failed to delete discussion comment",
+		},
+		{
+			name: "lockdown restricts discussion comments of users without push access",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewQueryMatcher(discussionCommentQuery{}, map[string]any{"id": "DC_kwDOA1"}, commentResponse("DiscussionComment", "testuser", "owner", "repo")),
+			},
+			requestArgs:     map[string]any{"method": "delete", "owner": "owner", "repo": "repo", "commentId": "DC_kwDOA1"},
+			lockdownEnabled: true,
+			expectError:     true,
+			expectedErrMsg:  "access to discussion comment is restricted by lockdown mode",
+		},
+		{
+			name: "lockdown allows discussion comments in private repositories",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewQueryMatcher(discussionCommentQuery{}, map[string]any{"id": "DC_kwDOA1"}, commentResponse("DiscussionComment", "testuser2", "owner2", "repo2")),
+				githubv4mock.NewMutationMatcher(
+					deleteMutation,
+					githubv4.DeleteDiscussionCommentInput{
+						ID: githubv4.ID("DC_kwDOA1"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"deleteDiscussionComment": map[string]any{
+							"comment": map[string]any{"id": "DC_kwDOA1"},
+						},
+					}),
+				),
+			},
+			requestArgs:     map[string]any{"method": "delete", "owner": "owner2", "repo": "repo2", "commentId": "DC_kwDOA1"},
+			lockdownEnabled: true,
+			expectedText:    "discussion comment DC_kwDOA1 deleted successfully",
+		},
+		{
+			name: "lockdown rejects comments from another repository",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewQueryMatcher(discussionCommentQuery{}, map[string]any{"id": "DC_kwDOA1"}, commentResponse("DiscussionComment", "testuser", "owner", "repo")),
+			},
+			requestArgs:     map[string]any{"method": "delete", "owner": "owner2", "repo": "repo2", "commentId": "DC_kwDOA1"},
+			lockdownEnabled: true,
+			expectError:     true,
+			expectedErrMsg:  "discussion comment DC_kwDOA1 does not belong to owner2/repo2",
+		},
+		{
+			name: "lockdown rejects nodes that are not discussion comments",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewQueryMatcher(discussionCommentQuery{}, map[string]any{"id": "IC_kwDOA1"}, githubv4mock.DataResponse(map[string]any{
+					"node": map[string]any{"__typename": "IssueComment"},
+				})),
+			},
+			requestArgs:     map[string]any{"method": "delete", "owner": "owner2", "repo": "repo2", "commentId": "IC_kwDOA1"},
+			lockdownEnabled: true,
+			expectError:     true,
+			expectedErrMsg:  "IC_kwDOA1 is not a discussion comment",
+		},
+		{
+			name: "lockdown rejects comments without a known author",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewQueryMatcher(discussionCommentQuery{}, map[string]any{"id": "DC_kwDOA1"}, commentResponse("DiscussionComment", "", "owner2", "repo2")),
+			},
+			requestArgs:     map[string]any{"method": "delete", "owner": "owner2", "repo": "repo2", "commentId": "DC_kwDOA1"},
+			lockdownEnabled: true,
+			expectError:     true,
+			expectedErrMsg:  "access to discussion comment is restricted by lockdown mode",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(tc.matchers...))
+			deps := BaseDeps{
+				GQLClient:       gqlClient,
+				RepoAccessCache: repoAccessCache,
+				Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": tc.lockdownEnabled}),
+			}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_DiscussionWrite(t *testing.T) {
+	toolDef := DiscussionWrite(translations.NullTranslationHelper)
+	tool := toolDef.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "discussion_write", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "category")
+	assert.Contains(t, schema.Properties, "closeReason")
+	assert.Contains(t, schema.Properties, "lockReason")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner"})
+
+	discussionIDMatcher := githubv4mock.NewQueryMatcher(discussionIDQuery, discussionIDVars, discussionIDResponse)
+	createMutation := struct {
+		CreateDiscussion struct {
+			Discussion struct {
+				ID     githubv4.ID
+				Number githubv4.Int
+				URL    githubv4.String `graphql:"url"`
+			}
+		} `graphql:"createDiscussion(input: $input)"`
+	}{}
+	repositoryIDQuery := struct {
+		Repository struct {
+			ID githubv4.ID
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}{}
+	closedReason := githubv4.DiscussionCloseReasonDuplicate
+	spamReason := githubv4.LockReasonSpam
+
+	tests := []struct {
+		name           string
+		matchers       []githubv4mock.Matcher
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "create discussion",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewQueryMatcher(repositoryIDQuery, map[string]any{
+					"owner": githubv4.String("owner"),
+					"repo":  githubv4.String("repo"),
+				}, githubv4mock.DataResponse(map[string]any{
+					"repository": map[string]any{"id": "R_kgDOA1"},
+				})),
+				githubv4mock.NewMutationMatcher(
+					createMutation,
+					githubv4.CreateDiscussionInput{
+						RepositoryID: githubv4.ID("R_kgDOA1"),
+						CategoryID:   githubv4.ID("DIC_kwDOA1"),
+						Title:        githubv4.String("How do I configure toolsets?"),
+						Body:         githubv4.String("I could not find it in the docs."),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"createDiscussion": map[string]any{
+							"discussion": map[string]any{
+								"id":     "D_kwDOA5",
+								"number": 5,
+								"url":    "https://github.com/owner/repo/discussions/5",
+							},
+						},
+					}),
+				),
+			},
+			requestArgs: map[string]any{
+				"method":   "create",
+				"owner":    "owner",
+				"repo":     "repo",
+				"category": "DIC_kwDOA1",
+				"title":    "How do I configure toolsets?",
+				"body":     "I could not find it in the docs.",
+			},
+			expectedText: `{"id":"5","url":"https://github.com/owner/repo/discussions/5"}`,
+		},
+		{
+			name: "create organisation discussion defaults to .github repository",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewQueryMatcher(repositoryIDQuery, map[string]any{
+					"owner": githubv4.String("owner"),
+					"repo":  githubv4.String(".github"),
+				}, githubv4mock.DataResponse(map[string]any{
+					"repository": map[string]any{"id": "R_kgDOA2"},
+				})),
+				githubv4mock.NewMutationMatcher(
+					createMutation,
+					githubv4.CreateDiscussionInput{
+						RepositoryID: githubv4.ID("R_kgDOA2"),
+						CategoryID:   githubv4.ID("DIC_kwDOA2"),
+						Title:        githubv4.String("Announcement"),
+						Body:         githubv4.String("Hello"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"createDiscussion": map[string]any{
+							"discussion": map[string]any{
+								"id":     "D_kwDOA6",
+								"number": 6,
+								"url":    "https://github.com/owner/.github/discussions/6",
+							},
+						},
+					}),
+				),
+			},
+			requestArgs: map[string]any{
+				"method":   "create",
+				"owner":    "owner",
+				"category": "DIC_kwDOA2",
+				"title":    "Announcement",
+				"body":     "Hello",
+			},
+			expectedText: `{"id":"6","url":"https://github.com/owner/.github/discussions/6"}`,
+		},
+		{
+			name:           "create discussion without category",
+			requestArgs:    map[string]any{"method": "create", "owner": "owner", "repo": "repo", "title": "t", "body": "b"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: category",
+		},
+		{
+			name:           "update discussion without changes",
+			matchers:       []githubv4mock.Matcher{discussionIDMatcher},
+			requestArgs:    map[string]any{"method": "update", "owner": "owner", "repo": "repo", "discussionNumber": float64(1)},
+			expectError:    true,
+			expectedErrMsg: "at least one of title, body or category must be provided for update",
+		},
+		{
+			name: "close discussion as duplicate",
+			matchers: []githubv4mock.Matcher{
+				discussionIDMatcher,
+				githubv4mock.NewMutationMatcher(
+					struct {
+						CloseDiscussion struct {
+							Discussion struct {
+								ID     githubv4.ID
+								Number githubv4.Int
+								URL    githubv4.String `graphql:"url"`
+							}
+						} `graphql:"closeDiscussion(input: $input)"`
+					}{},
+					githubv4.CloseDiscussionInput{
+						DiscussionID: githubv4.ID("D_kwDOA1"),
+						Reason:       &closedReason,
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"closeDiscussion": map[string]any{
+							"discussion": map[string]any{
+								"id":     "D_kwDOA1",
+								"number": 1,
+								"url":    "https://github.com/owner/repo/discussions/1",
+							},
+						},
+					}),
+				),
+			},
+			requestArgs:  map[string]any{"method": "close", "owner": "owner", "repo": "repo", "discussionNumber": float64(1), "closeReason": "DUPLICATE"},
+			expectedText: `{"id":"1","url":"https://github.com/owner/repo/discussions/1"}`,
+		},
+		{
+			name: "lock discussion",
+			matchers: []githubv4mock.Matcher{
+				discussionIDMatcher,
+				githubv4mock.NewMutationMatcher(
+					struct {
+						LockLockable struct {
+							LockedRecord struct {
+								Locked githubv4.Boolean
+							}
+						} `graphql:"lockLockable(input: $input)"`
+					}{},
+					githubv4.LockLockableInput{
+						LockableID: githubv4.ID("D_kwDOA1"),
+						LockReason: &spamReason,
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"lockLockable": map[string]any{
+							"lockedRecord": map[string]any{"locked": true},
+						},
+					}),
+				),
+			},
+			requestArgs:  map[string]any{"method": "lock", "owner": "owner", "repo": "repo", "discussionNumber": float64(1), "lockReason": "SPAM"},
+			expectedText: "discussion #1 locked successfully",
+		},
+		{
+			name: "discussion not found",
+			matchers: []githubv4mock.Matcher{
+				githubv4mock.NewQueryMatcher(discussionIDQuery, discussionIDVars, githubv4mock.ErrorResponse("Could not resolve to a Discussion with the number of 1.")),
+			},
+			requestArgs:    map[string]any{"method": "reopen", "owner": "owner", "repo": "repo", "discussionNumber": float64(1)},
+			expectError:    true,
+			expectedErrMsg: "failed to get discussion",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(tc.matchers...))
+			deps := BaseDeps{GQLClient: gqlClient}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
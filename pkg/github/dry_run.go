@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// DryRunResult describes the GitHub API request a write tool would have sent.
+type DryRunResult struct {
+	DryRun   bool                       `json:"dry_run"`
+	Tool     string                     `json:"tool"`
+	Requests []ghcontext.PlannedRequest `json:"requests"`
+	Note     string                     `json:"note"`
+}
+
+// DryRunMiddleware returns middleware that runs calls to tools without ReadOnlyHint
+// in dry-run mode when enabled is set or the request context asks for it. The tool
+// validates its parameters and makes its read requests as usual, but its first write
+// request is recorded instead of sent (see transport.DryRunTransport) and returned
+// to the client as a DryRunResult. Calls that fail before making a write request
+// return the tool's own result, so parameter errors are reported unchanged.
+func DryRunMiddleware(inv *inventory.Inventory, enabled bool) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, request mcp.Request) (mcp.Result, error) {
+			req, ok := request.(*mcp.CallToolRequest)
+			if !ok || method != inventory.MCPMethodToolsCall || req.Params == nil {
+				return next(ctx, method, request)
+			}
+			if !enabled && !ghcontext.IsDryRun(ctx) {
+				return next(ctx, method, request)
+			}
+			// Tools outside the inventory, such as the dynamic toolset tools, only change server state
+			tool, _, err := inv.FindToolByName(req.Params.Name)
+			if err != nil || tool.IsReadOnly() {
+				return next(ctx, method, request)
+			}
+
+			ctx, recorder := ghcontext.WithDryRunRecorder(ctx)
+			result, err := next(ctx, method, request)
+
+			planned := recorder.Requests()
+			if len(planned) == 0 {
+				return result, err
+			}
+			return MarshalledTextResult(DryRunResult{
+				DryRun:   true,
+				Tool:     req.Params.Name,
+				Requests: planned,
+				Note:     "Dry-run mode: no changes were made. Any later requests depend on the response to this one and are not shown.",
+			}), nil
+		}
+	}
+}
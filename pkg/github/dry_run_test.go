@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/github/github-mcp-server/pkg/http/transport"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DryRunMiddleware(t *testing.T) {
+	inv, err := NewInventory(translations.NullTranslationHelper).WithToolsets([]string{"all"}).Build()
+	require.NoError(t, err)
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		PostReposIssuesByOwnerByRepo: func(w http.ResponseWriter, _ *http.Request) {
+			t.Error("write request reached the API in dry-run mode")
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+		GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, &github.Issue{Number: github.Ptr(42)}),
+	})
+	mockedClient.Transport = &transport.DryRunTransport{Transport: mockedClient.Transport}
+	deps := BaseDeps{Client: github.NewClient(mockedClient)}
+
+	// callTool dispatches a tools/call request to the matching tool handler
+	callTool := func(ctx context.Context, _ string, request mcp.Request) (mcp.Result, error) {
+		req := request.(*mcp.CallToolRequest)
+		tool, _, err := inv.FindToolByName(req.Params.Name)
+		require.NoError(t, err)
+		return tool.Handler(deps)(ContextWithDeps(ctx, deps), req)
+	}
+
+	newRequest := func(name string, args map[string]any) *mcp.CallToolRequest {
+		req := createMCPRequest(args)
+		req.Params.Name = name
+		return &req
+	}
+
+	createArgs := map[string]any{
+		"method": "create",
+		"owner":  "owner",
+		"repo":   "repo",
+		"title":  "Planned issue",
+	}
+
+	t.Run("write tool returns the planned request", func(t *testing.T) {
+		handler := DryRunMiddleware(inv, true)(callTool)
+		result, err := handler(context.Background(), inventory.MCPMethodToolsCall, newRequest("issue_write", createArgs))
+		require.NoError(t, err)
+
+		textContent := getTextResult(t, result.(*mcp.CallToolResult))
+		var dryRun DryRunResult
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &dryRun))
+
+		assert.True(t, dryRun.DryRun)
+		assert.Equal(t, "issue_write", dryRun.Tool)
+		require.Len(t, dryRun.Requests, 1)
+		assert.Equal(t, http.MethodPost, dryRun.Requests[0].Method)
+		assert.Contains(t, dryRun.Requests[0].URL, "/repos/owner/repo/issues")
+		assert.JSONEq(t, `{"title":"Planned issue","body":"","labels":[],"assignees":[]}`, string(dryRun.Requests[0].Body))
+	})
+
+	t.Run("enabled by request context", func(t *testing.T) {
+		handler := DryRunMiddleware(inv, false)(callTool)
+		ctx := ghcontext.WithDryRun(context.Background(), true)
+		result, err := handler(ctx, inventory.MCPMethodToolsCall, newRequest("issue_write", createArgs))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result.(*mcp.CallToolResult)).Text, `"dry_run":true`)
+	})
+
+	t.Run("parameter errors are returned unchanged", func(t *testing.T) {
+		handler := DryRunMiddleware(inv, true)(callTool)
+		result, err := handler(context.Background(), inventory.MCPMethodToolsCall, newRequest("issue_write", map[string]any{
+			"method": "create",
+			"owner":  "owner",
+			"repo":   "repo",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result.(*mcp.CallToolResult)).Text, "missing required parameter: title")
+	})
+
+	t.Run("read-only tools run normally", func(t *testing.T) {
+		handler := DryRunMiddleware(inv, true)(callTool)
+		result, err := handler(context.Background(), inventory.MCPMethodToolsCall, newRequest("issue_read", map[string]any{
+			"method":       "get",
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+		}))
+		require.NoError(t, err)
+		assert.NotContains(t, getTextResult(t, result.(*mcp.CallToolResult)).Text, "dry_run")
+	})
+}
@@ -1,10 +1,14 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/inventory"
@@ -14,6 +18,7 @@ import (
 	"github.com/google/go-github/v82/github"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
 )
 
 // TreeEntryResponse represents a single entry in a Git tree.
@@ -175,3 +180,889 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 		},
 	)
 }
+
+// blameCommit is the blame of a file at a commit, with the commit, author and pull
+// request that last changed each range.
+type blameCommit struct {
+	OID   githubv4.GitObjectID `graphql:"oid"`
+	Blame struct {
+		Ranges []struct {
+			StartingLine githubv4.Int
+			EndingLine   githubv4.Int
+			Commit       struct {
+				OID             githubv4.GitObjectID `graphql:"oid"`
+				MessageHeadline githubv4.String
+				CommittedDate   githubv4.DateTime
+				Author          struct {
+					Name  githubv4.String
+					Email githubv4.String
+					User  *struct {
+						Login githubv4.String
+					}
+				}
+				AssociatedPullRequests struct {
+					Nodes []struct {
+						Number githubv4.Int
+					}
+				} `graphql:"associatedPullRequests(first: 1)"`
+			}
+		}
+	} `graphql:"blame(path: $path)"`
+}
+
+// blameQuery fetches the blame of a file at a ref, peeling annotated tags to the
+// commit they point to.
+type blameQuery struct {
+	Repository struct {
+		Object *struct {
+			Typename githubv4.String `graphql:"__typename"`
+			Commit   blameCommit     `graphql:"... on Commit"`
+			Tag      struct {
+				Target struct {
+					Typename githubv4.String `graphql:"__typename"`
+					Commit   blameCommit     `graphql:"... on Commit"`
+				}
+			} `graphql:"... on Tag"`
+		} `graphql:"object(expression: $ref)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// BlameCommit describes a commit that last changed one or more ranges of a blamed file.
+type BlameCommit struct {
+	Message     string `json:"message"`
+	Author      string `json:"author"`
+	Date        string `json:"date"`
+	PullRequest int    `json:"pr,omitempty"`
+}
+
+// BlameResponse is the compact output of get_file_blame. Each range is written as
+// "start-end:sha", and the commits referenced by the ranges are listed once by SHA.
+type BlameResponse struct {
+	Path    string                 `json:"path"`
+	SHA     string                 `json:"sha"`
+	Ranges  []string               `json:"ranges"`
+	Commits map[string]BlameCommit `json:"commits"`
+}
+
+// GetFileBlame creates a tool to get the blame of a file in a GitHub repository.
+func GetFileBlame(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name: "get_file_blame",
+			Description: t("TOOL_GET_FILE_BLAME_DESCRIPTION", `Get the blame of a file in a GitHub repository: the commit that last changed each line.
+Lines are grouped into ranges written as "start-end:sha". Each commit is listed once with its message, author, date and the number of the pull request that introduced it, if any.
+Use start_line and end_line to limit the result to the lines of interest.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_FILE_BLAME_USER_TITLE", "Get file blame"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"path": {
+						Type:        "string",
+						Description: "Path to the file, relative to the repository root",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch, tag or commit SHA to blame the file at. Defaults to the repository's default branch",
+					},
+					"start_line": {
+						Type:        "number",
+						Description: "First line to include (1-based). Defaults to the start of the file",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+					"end_line": {
+						Type:        "number",
+						Description: "Last line to include. Defaults to the end of the file",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+				},
+				Required: []string{"owner", "repo", "path"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			path, err := RequiredParam[string](args, "path")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			startLine, err := OptionalIntParam(args, "start_line")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			endLine, err := OptionalIntParam(args, "end_line")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if endLine > 0 && startLine > endLine {
+				return utils.NewToolResultError("start_line must not be greater than end_line"), nil, nil
+			}
+			if ref == "" {
+				ref = "HEAD"
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			var query blameQuery
+			vars := map[string]any{
+				"owner": githubv4.String(owner),
+				"repo":  githubv4.String(repo),
+				"ref":   githubv4.String(ref),
+				"path":  githubv4.String(strings.TrimPrefix(path, "/")),
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get file blame", err), nil, nil
+			}
+			if query.Repository.Object == nil {
+				return utils.NewToolResultError(fmt.Sprintf("ref '%s' not found in %s/%s", ref, owner, repo)), nil, nil
+			}
+			var commit blameCommit
+			switch object := query.Repository.Object; {
+			case object.Typename == "Commit":
+				commit = object.Commit
+			case object.Typename == "Tag" && object.Tag.Target.Typename == "Commit":
+				commit = object.Tag.Target.Commit
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("ref '%s' in %s/%s does not point to a commit", ref, owner, repo)), nil, nil
+			}
+			response := BlameResponse{
+				Path:    path,
+				SHA:     string(commit.OID),
+				Ranges:  []string{},
+				Commits: map[string]BlameCommit{},
+			}
+			for _, r := range commit.Blame.Ranges {
+				start, end := int(r.StartingLine), int(r.EndingLine)
+				if (startLine > 0 && end < startLine) || (endLine > 0 && start > endLine) {
+					continue
+				}
+				start = max(start, startLine)
+				if endLine > 0 {
+					end = min(end, endLine)
+				}
+
+				sha := string(r.Commit.OID)
+				if len(sha) > 12 {
+					sha = sha[:12]
+				}
+				response.Ranges = append(response.Ranges, fmt.Sprintf("%d-%d:%s", start, end, sha))
+				if _, ok := response.Commits[sha]; ok {
+					continue
+				}
+
+				author := string(r.Commit.Author.Name)
+				if r.Commit.Author.User != nil {
+					author = string(r.Commit.Author.User.Login)
+				}
+				blameCommit := BlameCommit{
+					Message: string(r.Commit.MessageHeadline),
+					Author:  author,
+					Date:    r.Commit.CommittedDate.Format("2006-01-02T15:04:05Z"),
+				}
+				if len(r.Commit.AssociatedPullRequests.Nodes) > 0 {
+					blameCommit.PullRequest = int(r.Commit.AssociatedPullRequests.Nodes[0].Number)
+				}
+				response.Commits[sha] = blameCommit
+			}
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// maxBlobSize is the largest blob returned by git_read.
+const maxBlobSize = 1024 * 1024 // 1MB
+
+// treeEntryTypes maps the file modes accepted by create_tree to the type of object they point to.
+var treeEntryTypes = map[string]string{
+	"100644": "blob",   // file
+	"100755": "blob",   // executable
+	"120000": "blob",   // symlink, whose content is the link target
+	"040000": "tree",   // subdirectory
+	"160000": "commit", // submodule
+}
+
+// BlobResponse is the output of git_read get_blob.
+type BlobResponse struct {
+	SHA      string `json:"sha"`
+	Size     int    `json:"size"`
+	Encoding string `json:"encoding"`
+	Content  string `json:"content"`
+}
+
+// GitCommitResponse is the output of git_read get_commit.
+type GitCommitResponse struct {
+	SHA       string               `json:"sha"`
+	Message   string               `json:"message"`
+	Tree      string               `json:"tree"`
+	Parents   []string             `json:"parents"`
+	Author    *MinimalCommitAuthor `json:"author,omitempty"`
+	Committer *MinimalCommitAuthor `json:"committer,omitempty"`
+	Verified  bool                 `json:"verified"`
+	HTMLURL   string               `json:"html_url,omitempty"`
+}
+
+// GitRefResponse is the output of the ref methods of git_read and git_write.
+type GitRefResponse struct {
+	Ref  string `json:"ref"`
+	SHA  string `json:"sha"`
+	Type string `json:"type,omitempty"`
+}
+
+// qualifiedRef returns ref as a fully qualified reference. References starting with
+// "heads/" or "tags/" get the "refs/" prefix, and anything else is taken as a branch name.
+func qualifiedRef(ref string) string {
+	switch {
+	case strings.HasPrefix(ref, "refs/"):
+		return ref
+	case strings.HasPrefix(ref, "heads/"), strings.HasPrefix(ref, "tags/"):
+		return "refs/" + ref
+	default:
+		return "refs/heads/" + ref
+	}
+}
+
+func convertToGitRefResponse(ref *github.Reference) GitRefResponse {
+	return GitRefResponse{
+		Ref:  ref.GetRef(),
+		SHA:  ref.GetObject().GetSHA(),
+		Type: ref.GetObject().GetType(),
+	}
+}
+
+func convertToCommitAuthor(author *github.CommitAuthor) *MinimalCommitAuthor {
+	if author == nil {
+		return nil
+	}
+	minimalAuthor := &MinimalCommitAuthor{
+		Name:  author.GetName(),
+		Email: author.GetEmail(),
+	}
+	if author.Date != nil {
+		minimalAuthor.Date = author.Date.Format("2006-01-02T15:04:05Z")
+	}
+	return minimalAuthor
+}
+
+// GitRead creates a tool to read Git objects and references of a GitHub repository.
+func GitRead(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name: "git_read",
+			Description: t("TOOL_GIT_READ_DESCRIPTION", `Read Git objects and references of a GitHub repository.
+Methods:
+- get_blob: get the content of a blob by SHA. Text content is returned as UTF-8, anything else as base64.
+- get_commit: get a commit object by SHA, with its tree and parents.
+- get_ref: get the SHA a branch or tag reference points to.
+Use get_repository_tree to list the entries of a tree.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GIT_READ_USER_TITLE", "Read Git objects"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "Operation to perform",
+						Enum:        []any{"get_blob", "get_commit", "get_ref"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "Object SHA. Required for 'get_blob' and 'get_commit'.",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Reference such as 'heads/main', 'tags/v1.0.0' or a branch name. Required for 'get_ref'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case "get_blob":
+				result, err := getGitBlob(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "get_commit":
+				result, err := getGitCommit(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "get_ref":
+				result, err := getGitRef(ctx, client, owner, repo, args)
+				return result, nil, err
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+func getGitBlob(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	sha, err := RequiredParam[string](args, "sha")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	blob, resp, err := client.Git.GetBlob(ctx, owner, repo, sha)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get blob", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if blob.GetSize() > maxBlobSize {
+		return utils.NewToolResultError(fmt.Sprintf("blob %s is %d bytes, larger than the %d byte limit", sha, blob.GetSize(), maxBlobSize)), nil
+	}
+
+	content := []byte(blob.GetContent())
+	if blob.GetEncoding() == "base64" {
+		content, err = base64.StdEncoding.DecodeString(strings.ReplaceAll(blob.GetContent(), "\n", ""))
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to decode blob content", err), nil
+		}
+	}
+
+	response := BlobResponse{
+		SHA:      blob.GetSHA(),
+		Size:     blob.GetSize(),
+		Encoding: "utf-8",
+		Content:  string(content),
+	}
+	if !utf8.Valid(content) || bytes.IndexByte(content, 0) >= 0 {
+		response.Encoding = "base64"
+		response.Content = base64.StdEncoding.EncodeToString(content)
+	}
+	return MarshalledTextResult(response), nil
+}
+
+func getGitCommit(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	sha, err := RequiredParam[string](args, "sha")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	commit, resp, err := client.Git.GetCommit(ctx, owner, repo, sha)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get commit", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToGitCommitResponse(commit)), nil
+}
+
+func convertToGitCommitResponse(commit *github.Commit) GitCommitResponse {
+	response := GitCommitResponse{
+		SHA:       commit.GetSHA(),
+		Message:   commit.GetMessage(),
+		Tree:      commit.GetTree().GetSHA(),
+		Parents:   make([]string, len(commit.Parents)),
+		Author:    convertToCommitAuthor(commit.Author),
+		Committer: convertToCommitAuthor(commit.Committer),
+		Verified:  commit.GetVerification().GetVerified(),
+		HTMLURL:   commit.GetHTMLURL(),
+	}
+	for i, parent := range commit.Parents {
+		response.Parents[i] = parent.GetSHA()
+	}
+	return response
+}
+
+func getGitRef(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	ref, err := RequiredParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	reference, resp, err := client.Git.GetRef(ctx, owner, repo, qualifiedRef(ref))
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get reference", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToGitRefResponse(reference)), nil
+}
+
+// GitWrite creates a tool to create Git objects and update references of a GitHub repository.
+func GitWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name: "git_write",
+			Description: t("TOOL_GIT_WRITE_DESCRIPTION", `Create Git objects and update references of a GitHub repository, to build commits that push_files and create_or_update_file cannot express.
+Methods:
+- create_blob: store file content and return its SHA.
+- create_tree: create a tree from entries, optionally on top of base_tree. Entries can set file modes, point to existing blobs, trees or submodule commits, or delete paths from base_tree.
+- create_commit: create a commit for a tree. Use several parents for a merge commit, or none for a root commit. No branch moves until update_ref is called.
+- create_ref: create a branch or tag pointing at a SHA.
+- update_ref: move a branch to a SHA. Set force to move it to a commit that does not descend from the current one.
+- delete_ref: delete a branch or tag.
+A typical multi-file commit is: git_read get_ref, git_read get_commit for its tree, create_tree with base_tree, create_commit with the old commit as parent, then update_ref.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_GIT_WRITE_USER_TITLE", "Write Git objects"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "Operation to perform",
+						Enum:        []any{"create_blob", "create_tree", "create_commit", "create_ref", "update_ref", "delete_ref"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"content": {
+						Type:        "string",
+						Description: "Blob content. Required for 'create_blob'.",
+					},
+					"encoding": {
+						Type:        "string",
+						Description: "Encoding of content: 'utf-8' for text or 'base64' for binary files",
+						Enum:        []any{"utf-8", "base64"},
+						Default:     json.RawMessage(`"utf-8"`),
+					},
+					"base_tree": {
+						Type:        "string",
+						Description: "SHA of the tree to apply the entries to for 'create_tree'. If omitted, the tree contains only the given entries.",
+					},
+					"tree": {
+						Type:        "array",
+						Description: "Tree entries for 'create_tree'. Each entry sets exactly one of sha, content or delete.",
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"path": {
+									Type:        "string",
+									Description: "Path of the entry, relative to the tree root",
+								},
+								"mode": {
+									Type:        "string",
+									Description: "File mode: 100644 for a file, 100755 for an executable, 120000 for a symlink, 040000 for a subdirectory, 160000 for a submodule. Default is 100644.",
+									Enum:        []any{"100644", "100755", "120000", "040000", "160000"},
+								},
+								"sha": {
+									Type:        "string",
+									Description: "SHA of an existing blob, tree or commit",
+								},
+								"content": {
+									Type:        "string",
+									Description: "UTF-8 file content, or the link target for a symlink",
+								},
+								"delete": {
+									Type:        "boolean",
+									Description: "Remove the path from base_tree. Set mode to 040000 to remove a subdirectory.",
+								},
+							},
+							Required: []string{"path"},
+						},
+					},
+					"message": {
+						Type:        "string",
+						Description: "Commit message. Required for 'create_commit'.",
+					},
+					"tree_sha": {
+						Type:        "string",
+						Description: "SHA of the tree for 'create_commit'",
+					},
+					"parents": {
+						Type:        "array",
+						Description: "Parent commit SHAs for 'create_commit'. Empty for a root commit, several for a merge commit.",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"author": {
+						Type:        "object",
+						Description: "Author of the commit for 'create_commit'. Defaults to the authenticated user.",
+						Properties: map[string]*jsonschema.Schema{
+							"name": {
+								Type: "string",
+							},
+							"email": {
+								Type: "string",
+							},
+							"date": {
+								Type:        "string",
+								Description: "ISO 8601 timestamp",
+							},
+						},
+						Required: []string{"name", "email"},
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Reference such as 'heads/main', 'tags/v1.0.0' or a branch name. Required for the ref methods.",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "SHA the reference points to. Required for 'create_ref' and 'update_ref'.",
+					},
+					"force": {
+						Type:        "boolean",
+						Description: "For 'update_ref', allow moving the reference to a commit that does not descend from the current one. Default is false.",
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case "create_blob":
+				result, err := createGitBlob(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "create_tree":
+				result, err := createGitTree(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "create_commit":
+				result, err := createGitCommit(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "create_ref":
+				result, err := createGitRef(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "update_ref":
+				result, err := updateGitRef(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "delete_ref":
+				result, err := deleteGitRef(ctx, client, owner, repo, args)
+				return result, nil, err
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+func createGitBlob(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	content, err := RequiredParam[string](args, "content")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	encoding, err := OptionalParam[string](args, "encoding")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	switch encoding {
+	case "":
+		encoding = "utf-8"
+	case "utf-8":
+	case "base64":
+		if _, err := base64.StdEncoding.DecodeString(content); err != nil {
+			return utils.NewToolResultError(fmt.Sprintf("content is not valid base64: %s", err)), nil
+		}
+	default:
+		return utils.NewToolResultError(fmt.Sprintf("unsupported encoding: %s", encoding)), nil
+	}
+
+	blob, resp, err := client.Git.CreateBlob(ctx, owner, repo, github.Blob{
+		Content:  github.Ptr(content),
+		Encoding: github.Ptr(encoding),
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create blob", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(MinimalResponse{
+		ID:  blob.GetSHA(),
+		URL: blob.GetURL(),
+	}), nil
+}
+
+// treeEntriesFromArgs converts the tree argument of create_tree to tree entries.
+func treeEntriesFromArgs(args map[string]any) ([]*github.TreeEntry, error) {
+	items, ok := args["tree"].([]any)
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("tree must be a non-empty array of entries")
+	}
+
+	entries := make([]*github.TreeEntry, 0, len(items))
+	for i, item := range items {
+		entryArgs, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("tree entry %d must be an object", i)
+		}
+		path, err := RequiredParam[string](entryArgs, "path")
+		if err != nil {
+			return nil, fmt.Errorf("tree entry %d: %w", i, err)
+		}
+		mode, err := OptionalParam[string](entryArgs, "mode")
+		if err != nil {
+			return nil, fmt.Errorf("tree entry %s: %w", path, err)
+		}
+		sha, hasSHA, err := OptionalParamOK[string](entryArgs, "sha")
+		if err != nil {
+			return nil, fmt.Errorf("tree entry %s: %w", path, err)
+		}
+		content, hasContent, err := OptionalParamOK[string](entryArgs, "content")
+		if err != nil {
+			return nil, fmt.Errorf("tree entry %s: %w", path, err)
+		}
+		remove, err := OptionalParam[bool](entryArgs, "delete")
+		if err != nil {
+			return nil, fmt.Errorf("tree entry %s: %w", path, err)
+		}
+
+		set := 0
+		for _, ok := range []bool{hasSHA, hasContent, remove} {
+			if ok {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("tree entry %s must set exactly one of sha, content or delete", path)
+		}
+
+		if mode == "" {
+			mode = "100644"
+		}
+		entryType, ok := treeEntryTypes[mode]
+		if !ok {
+			return nil, fmt.Errorf("tree entry %s has unsupported mode %s", path, mode)
+		}
+		if hasContent && entryType != "blob" {
+			return nil, fmt.Errorf("tree entry %s: content can only be set for files and symlinks, use sha for mode %s", path, mode)
+		}
+
+		entry := &github.TreeEntry{
+			Path: github.Ptr(path),
+			Mode: github.Ptr(mode),
+			Type: github.Ptr(entryType),
+		}
+		switch {
+		case remove:
+			// An entry without sha or content is sent with a null sha, which deletes the path
+		case hasSHA:
+			entry.SHA = github.Ptr(sha)
+		default:
+			entry.Content = github.Ptr(content)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func createGitTree(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	baseTree, err := OptionalParam[string](args, "base_tree")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	entries, err := treeEntriesFromArgs(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	tree, resp, err := client.Git.CreateTree(ctx, owner, repo, baseTree, entries)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create tree", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(MinimalResponse{
+		ID: tree.GetSHA(),
+	}), nil
+}
+
+func createGitCommit(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	message, err := RequiredParam[string](args, "message")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	treeSHA, err := RequiredParam[string](args, "tree_sha")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	parents, err := OptionalStringArrayParam(args, "parents")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	commit := github.Commit{
+		Message: github.Ptr(message),
+		Tree:    &github.Tree{SHA: github.Ptr(treeSHA)},
+		Parents: make([]*github.Commit, len(parents)),
+	}
+	for i, parent := range parents {
+		commit.Parents[i] = &github.Commit{SHA: github.Ptr(parent)}
+	}
+
+	if authorArgs, ok := args["author"].(map[string]any); ok {
+		name, err := RequiredParam[string](authorArgs, "name")
+		if err != nil {
+			return utils.NewToolResultError(fmt.Sprintf("author: %s", err)), nil
+		}
+		email, err := RequiredParam[string](authorArgs, "email")
+		if err != nil {
+			return utils.NewToolResultError(fmt.Sprintf("author: %s", err)), nil
+		}
+		date, err := OptionalParam[string](authorArgs, "date")
+		if err != nil {
+			return utils.NewToolResultError(fmt.Sprintf("author: %s", err)), nil
+		}
+		commit.Author = &github.CommitAuthor{
+			Name:  github.Ptr(name),
+			Email: github.Ptr(email),
+		}
+		if date != "" {
+			parsed, err := time.Parse(time.RFC3339, date)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("author: invalid date %q, expected ISO 8601 format", date)), nil
+			}
+			commit.Author.Date = &github.Timestamp{Time: parsed}
+		}
+	} else if _, ok := args["author"]; ok {
+		return utils.NewToolResultError("author must be an object with name and email"), nil
+	}
+
+	created, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create commit", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToGitCommitResponse(created)), nil
+}
+
+func createGitRef(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	ref, err := RequiredParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	sha, err := RequiredParam[string](args, "sha")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	reference, resp, err := client.Git.CreateRef(ctx, owner, repo, github.CreateRef{
+		Ref: qualifiedRef(ref),
+		SHA: sha,
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create reference", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToGitRefResponse(reference)), nil
+}
+
+func updateGitRef(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	ref, err := RequiredParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	sha, err := RequiredParam[string](args, "sha")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	force, err := OptionalParam[bool](args, "force")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	reference, resp, err := client.Git.UpdateRef(ctx, owner, repo, qualifiedRef(ref), github.UpdateRef{
+		SHA:   sha,
+		Force: github.Ptr(force),
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update reference", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToGitRefResponse(reference)), nil
+}
+
+func deleteGitRef(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	ref, err := RequiredParam[string](args, "ref")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	resp, err := client.Git.DeleteRef(ctx, owner, repo, qualifiedRef(ref))
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete reference", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return utils.NewToolResultText(fmt.Sprintf("reference %s deleted successfully", qualifiedRef(ref))), nil
+}
@@ -7,10 +7,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v82/github"
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -175,3 +177,489 @@ func Test_GetRepositoryTree(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetFileBlame(t *testing.T) {
+	toolDef := GetFileBlame(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "get_file_blame", toolDef.Tool.Name)
+	assert.NotEmpty(t, toolDef.Tool.Description)
+	assert.True(t, toolDef.Tool.Annotations.ReadOnlyHint)
+
+	inputSchema, ok := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "expected InputSchema to be *jsonschema.Schema")
+	assert.Contains(t, inputSchema.Properties, "ref")
+	assert.Contains(t, inputSchema.Properties, "start_line")
+	assert.Contains(t, inputSchema.Properties, "end_line")
+	assert.ElementsMatch(t, inputSchema.Required, []string{"owner", "repo", "path"})
+
+	blameRange := func(start, end int, oid, message, name string, login any, pr any) map[string]any {
+		prs := []any{}
+		if pr != nil {
+			prs = append(prs, map[string]any{"number": pr})
+		}
+		var user any
+		if login != nil {
+			user = map[string]any{"login": login}
+		}
+		return map[string]any{
+			"startingLine": start,
+			"endingLine":   end,
+			"commit": map[string]any{
+				"oid":             oid,
+				"messageHeadline": message,
+				"committedDate":   "2024-05-01T10:00:00Z",
+				"author": map[string]any{
+					"name":  name,
+					"email": "dev@example.com",
+					"user":  user,
+				},
+				"associatedPullRequests": map[string]any{"nodes": prs},
+			},
+		}
+	}
+	blamedCommit := func(typename string) map[string]any {
+		return map[string]any{
+			"__typename": typename,
+			"oid":        "headsha",
+			"blame": map[string]any{
+				"ranges": []any{
+					blameRange(1, 10, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "Initial commit", "Mona", "octocat", nil),
+					blameRange(11, 12, "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", "Fix off-by-one", "Hubot", nil, 42),
+					blameRange(13, 30, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "Initial commit", "Mona", "octocat", nil),
+				},
+			},
+		}
+	}
+	blameResponse := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{"object": blamedCommit("Commit")},
+	})
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		vars           map[string]any
+		response       githubv4mock.GQLResponse
+		expectError    bool
+		expectedErrMsg string
+		expected       BlameResponse
+	}{
+		{
+			name: "whole file at the default branch",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "main.go",
+			},
+			vars:     map[string]any{"owner": "owner", "repo": "repo", "ref": "HEAD", "path": "main.go"},
+			response: blameResponse,
+			expected: BlameResponse{
+				Path:   "main.go",
+				SHA:    "headsha",
+				Ranges: []string{"1-10:aaaaaaaaaaaa", "11-12:bbbbbbbbbbbb", "13-30:aaaaaaaaaaaa"},
+				Commits: map[string]BlameCommit{
+					"aaaaaaaaaaaa": {Message: "Initial commit", Author: "octocat", Date: "2024-05-01T10:00:00Z"},
+					"bbbbbbbbbbbb": {Message: "Fix off-by-one", Author: "Hubot", Date: "2024-05-01T10:00:00Z", PullRequest: 42},
+				},
+			},
+		},
+		{
+			name: "line range is clipped",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"path":       "main.go",
+				"ref":        "v1.0.0",
+				"start_line": float64(5),
+				"end_line":   float64(11),
+			},
+			vars:     map[string]any{"owner": "owner", "repo": "repo", "ref": "v1.0.0", "path": "main.go"},
+			response: blameResponse,
+			expected: BlameResponse{
+				Path:   "main.go",
+				SHA:    "headsha",
+				Ranges: []string{"5-10:aaaaaaaaaaaa", "11-11:bbbbbbbbbbbb"},
+				Commits: map[string]BlameCommit{
+					"aaaaaaaaaaaa": {Message: "Initial commit", Author: "octocat", Date: "2024-05-01T10:00:00Z"},
+					"bbbbbbbbbbbb": {Message: "Fix off-by-one", Author: "Hubot", Date: "2024-05-01T10:00:00Z", PullRequest: 42},
+				},
+			},
+		},
+		{
+			name: "annotated tag is peeled to its commit",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "main.go",
+				"ref":   "v2.0.0",
+			},
+			vars: map[string]any{"owner": "owner", "repo": "repo", "ref": "v2.0.0", "path": "main.go"},
+			response: githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"object": map[string]any{
+						"__typename": "Tag",
+						"target":     blamedCommit("Commit"),
+					},
+				},
+			}),
+			expected: BlameResponse{
+				Path:   "main.go",
+				SHA:    "headsha",
+				Ranges: []string{"1-10:aaaaaaaaaaaa", "11-12:bbbbbbbbbbbb", "13-30:aaaaaaaaaaaa"},
+				Commits: map[string]BlameCommit{
+					"aaaaaaaaaaaa": {Message: "Initial commit", Author: "octocat", Date: "2024-05-01T10:00:00Z"},
+					"bbbbbbbbbbbb": {Message: "Fix off-by-one", Author: "Hubot", Date: "2024-05-01T10:00:00Z", PullRequest: 42},
+				},
+			},
+		},
+		{
+			name: "ref that is not a commit",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "main.go",
+				"ref":   "HEAD^{tree}",
+			},
+			vars: map[string]any{"owner": "owner", "repo": "repo", "ref": "HEAD^{tree}", "path": "main.go"},
+			response: githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{"object": map[string]any{"__typename": "Tree"}},
+			}),
+			expectError:    true,
+			expectedErrMsg: "ref 'HEAD^{tree}' in owner/repo does not point to a commit",
+		},
+		{
+			name: "ref not found",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "main.go",
+				"ref":   "missing",
+			},
+			vars:           map[string]any{"owner": "owner", "repo": "repo", "ref": "missing", "path": "main.go"},
+			response:       githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"object": nil}}),
+			expectError:    true,
+			expectedErrMsg: "ref 'missing' not found in owner/repo",
+		},
+		{
+			name: "invalid line range",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"path":       "main.go",
+				"start_line": float64(10),
+				"end_line":   float64(5),
+			},
+			expectError:    true,
+			expectedErrMsg: "start_line must not be greater than end_line",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var matchers []githubv4mock.Matcher
+			if tc.vars != nil {
+				vars := map[string]any{}
+				for k, v := range tc.vars {
+					vars[k] = githubv4.String(v.(string))
+				}
+				matchers = append(matchers, githubv4mock.NewQueryMatcher(blameQuery{}, vars, tc.response))
+			}
+
+			deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matchers...))}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response BlameResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_GitRead(t *testing.T) {
+	toolDef := GitRead(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "git_read", toolDef.Tool.Name)
+	assert.True(t, toolDef.Tool.Annotations.ReadOnlyHint)
+
+	inputSchema, ok := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "expected InputSchema to be *jsonschema.Schema")
+	assert.ElementsMatch(t, inputSchema.Required, []string{"method", "owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       any
+	}{
+		{
+			name: "text blob",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitBlobsByOwnerByRepoByFileSHA: mockResponse(t, http.StatusOK, &github.Blob{
+					SHA:      github.Ptr("blobsha"),
+					Size:     github.Ptr(12),
+					Encoding: github.Ptr("base64"),
+					Content:  github.Ptr("aGVsbG8g\nd29ybGQK\n"),
+				}),
+			}),
+			requestArgs: map[string]any{"method": "get_blob", "owner": "owner", "repo": "repo", "sha": "blobsha"},
+			expected:    &BlobResponse{SHA: "blobsha", Size: 12, Encoding: "utf-8", Content: "hello world\n"},
+		},
+		{
+			name: "binary blob",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitBlobsByOwnerByRepoByFileSHA: mockResponse(t, http.StatusOK, &github.Blob{
+					SHA:      github.Ptr("blobsha"),
+					Size:     github.Ptr(3),
+					Encoding: github.Ptr("base64"),
+					Content:  github.Ptr("AAEC"),
+				}),
+			}),
+			requestArgs: map[string]any{"method": "get_blob", "owner": "owner", "repo": "repo", "sha": "blobsha"},
+			expected:    &BlobResponse{SHA: "blobsha", Size: 3, Encoding: "base64", Content: "AAEC"},
+		},
+		{
+			name: "merge commit",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusOK, &github.Commit{
+					SHA:     github.Ptr("mergesha"),
+					Message: github.Ptr("Merge branch 'feature'"),
+					Tree:    &github.Tree{SHA: github.Ptr("treesha")},
+					Parents: []*github.Commit{{SHA: github.Ptr("parent1")}, {SHA: github.Ptr("parent2")}},
+					Author:  &github.CommitAuthor{Name: github.Ptr("Mona"), Email: github.Ptr("mona@example.com")},
+				}),
+			}),
+			requestArgs: map[string]any{"method": "get_commit", "owner": "owner", "repo": "repo", "sha": "mergesha"},
+			expected: &GitCommitResponse{
+				SHA:     "mergesha",
+				Message: "Merge branch 'feature'",
+				Tree:    "treesha",
+				Parents: []string{"parent1", "parent2"},
+				Author:  &MinimalCommitAuthor{Name: "Mona", Email: "mona@example.com"},
+			},
+		},
+		{
+			name: "branch name ref",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/git/ref/heads/main").andThen(
+					mockResponse(t, http.StatusOK, &github.Reference{
+						Ref:    github.Ptr("refs/heads/main"),
+						Object: &github.GitObject{SHA: github.Ptr("abc123"), Type: github.Ptr("commit")},
+					}),
+				),
+			}),
+			requestArgs: map[string]any{"method": "get_ref", "owner": "owner", "repo": "repo", "ref": "main"},
+			expected:    &GitRefResponse{Ref: "refs/heads/main", SHA: "abc123", Type: "commit"},
+		},
+		{
+			name:           "get_blob without sha",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "get_blob", "owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: sha",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			expected, err := json.Marshal(tc.expected)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expected), getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_GitWrite(t *testing.T) {
+	toolDef := GitWrite(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "git_write", toolDef.Tool.Name)
+	assert.False(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, toolDef.Tool.Annotations.DestructiveHint)
+	assert.True(t, *toolDef.Tool.Annotations.DestructiveHint)
+
+	inputSchema, ok := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "expected InputSchema to be *jsonschema.Schema")
+	assert.Contains(t, inputSchema.Properties, "tree")
+	assert.Contains(t, inputSchema.Properties, "parents")
+	assert.Contains(t, inputSchema.Properties, "force")
+	assert.ElementsMatch(t, inputSchema.Required, []string{"method", "owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "create binary blob",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposGitBlobsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"content":  "AAEC",
+					"encoding": "base64",
+				}).andThen(mockResponse(t, http.StatusCreated, &github.Blob{SHA: github.Ptr("blobsha")})),
+			}),
+			requestArgs:  map[string]any{"method": "create_blob", "owner": "owner", "repo": "repo", "content": "AAEC", "encoding": "base64"},
+			expectedText: `"id":"blobsha"`,
+		},
+		{
+			name: "create tree with modes and deletions",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposGitTreesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"base_tree": "basesha",
+					"tree": []any{
+						map[string]any{"path": "run.sh", "mode": "100755", "type": "blob", "content": "#!/bin/sh\n"},
+						map[string]any{"path": "latest", "mode": "120000", "type": "blob", "content": "v2"},
+						map[string]any{"path": "vendor/lib", "mode": "160000", "type": "commit", "sha": "libsha"},
+						map[string]any{"path": "logo.png", "mode": "100644", "type": "blob", "sha": "blobsha"},
+						map[string]any{"path": "old.txt", "mode": "100644", "type": "blob", "sha": nil},
+						map[string]any{"path": "legacy", "mode": "040000", "type": "tree", "sha": nil},
+					},
+				}).andThen(mockResponse(t, http.StatusCreated, &github.Tree{SHA: github.Ptr("newtreesha")})),
+			}),
+			requestArgs: map[string]any{
+				"method":    "create_tree",
+				"owner":     "owner",
+				"repo":      "repo",
+				"base_tree": "basesha",
+				"tree": []any{
+					map[string]any{"path": "run.sh", "mode": "100755", "content": "#!/bin/sh\n"},
+					map[string]any{"path": "latest", "mode": "120000", "content": "v2"},
+					map[string]any{"path": "vendor/lib", "mode": "160000", "sha": "libsha"},
+					map[string]any{"path": "logo.png", "sha": "blobsha"},
+					map[string]any{"path": "old.txt", "delete": true},
+					map[string]any{"path": "legacy", "mode": "040000", "delete": true},
+				},
+			},
+			expectedText: `"id":"newtreesha"`,
+		},
+		{
+			name:         "tree entry with sha and content",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method": "create_tree",
+				"owner":  "owner",
+				"repo":   "repo",
+				"tree":   []any{map[string]any{"path": "a.txt", "sha": "blobsha", "content": "a"}},
+			},
+			expectError:    true,
+			expectedErrMsg: "tree entry a.txt must set exactly one of sha, content or delete",
+		},
+		{
+			name: "create merge commit",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposGitCommitsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"message": "Merge feature",
+					"tree":    "treesha",
+					"parents": []any{"parent1", "parent2"},
+					"author": map[string]any{
+						"name":  "Mona",
+						"email": "mona@example.com",
+						"date":  "2024-05-01T10:00:00Z",
+					},
+				}).andThen(mockResponse(t, http.StatusCreated, &github.Commit{
+					SHA:     github.Ptr("mergesha"),
+					Tree:    &github.Tree{SHA: github.Ptr("treesha")},
+					Parents: []*github.Commit{{SHA: github.Ptr("parent1")}, {SHA: github.Ptr("parent2")}},
+				})),
+			}),
+			requestArgs: map[string]any{
+				"method":   "create_commit",
+				"owner":    "owner",
+				"repo":     "repo",
+				"message":  "Merge feature",
+				"tree_sha": "treesha",
+				"parents":  []any{"parent1", "parent2"},
+				"author":   map[string]any{"name": "Mona", "email": "mona@example.com", "date": "2024-05-01T10:00:00Z"},
+			},
+			expectedText: `"parents":["parent1","parent2"]`,
+		},
+		{
+			name: "force update ref",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposGitRefsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/git/refs/heads/feature").andThen(
+					expectRequestBody(t, map[string]any{"sha": "newsha", "force": true}).andThen(
+						mockResponse(t, http.StatusOK, &github.Reference{
+							Ref:    github.Ptr("refs/heads/feature"),
+							Object: &github.GitObject{SHA: github.Ptr("newsha"), Type: github.Ptr("commit")},
+						}),
+					),
+				),
+			}),
+			requestArgs:  map[string]any{"method": "update_ref", "owner": "owner", "repo": "repo", "ref": "heads/feature", "sha": "newsha", "force": true},
+			expectedText: `"sha":"newsha"`,
+		},
+		{
+			name: "create tag ref",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposGitRefsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"ref": "refs/tags/v1.0.0",
+					"sha": "abc123",
+				}).andThen(mockResponse(t, http.StatusCreated, &github.Reference{
+					Ref:    github.Ptr("refs/tags/v1.0.0"),
+					Object: &github.GitObject{SHA: github.Ptr("abc123"), Type: github.Ptr("commit")},
+				})),
+			}),
+			requestArgs:  map[string]any{"method": "create_ref", "owner": "owner", "repo": "repo", "ref": "tags/v1.0.0", "sha": "abc123"},
+			expectedText: `"ref":"refs/tags/v1.0.0"`,
+		},
+		{
+			name: "delete ref",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposGitRefsByOwnerByRepoByRef: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}),
+			requestArgs:  map[string]any{"method": "delete_ref", "owner": "owner", "repo": "repo", "ref": "old-branch"},
+			expectedText: "reference refs/heads/old-branch deleted successfully",
+		},
+		{
+			name: "update ref fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposGitRefsByOwnerByRepoByRef: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Update is not a fast forward"}`),
+			}),
+			requestArgs:    map[string]any{"method": "update_ref", "owner": "owner", "repo": "repo", "ref": "main", "sha": "newsha"},
+			expectError:    true,
+			expectedErrMsg: "failed to update reference",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
@@ -28,17 +28,18 @@ const (
 	DeleteUserStarredByOwnerByRepo = "DELETE /user/starred/{owner}/{repo}"
 
 	// Repository endpoints
-	GetReposByOwnerByRepo                = "GET /repos/{owner}/{repo}"
-	GetReposBranchesByOwnerByRepo        = "GET /repos/{owner}/{repo}/branches"
-	GetReposTagsByOwnerByRepo            = "GET /repos/{owner}/{repo}/tags"
-	GetReposCommitsByOwnerByRepo         = "GET /repos/{owner}/{repo}/commits"
-	GetReposCommitsByOwnerByRepoByRef    = "GET /repos/{owner}/{repo}/commits/{ref}"
-	GetReposContentsByOwnerByRepoByPath  = "GET /repos/{owner}/{repo}/contents/{path}"
-	PutReposContentsByOwnerByRepoByPath  = "PUT /repos/{owner}/{repo}/contents/{path}"
-	PostReposForksByOwnerByRepo          = "POST /repos/{owner}/{repo}/forks"
-	GetReposSubscriptionByOwnerByRepo    = "GET /repos/{owner}/{repo}/subscription"
-	PutReposSubscriptionByOwnerByRepo    = "PUT /repos/{owner}/{repo}/subscription"
-	DeleteReposSubscriptionByOwnerByRepo = "DELETE /repos/{owner}/{repo}/subscription"
+	GetReposByOwnerByRepo                  = "GET /repos/{owner}/{repo}"
+	GetReposBranchesByOwnerByRepo          = "GET /repos/{owner}/{repo}/branches"
+	GetReposTagsByOwnerByRepo              = "GET /repos/{owner}/{repo}/tags"
+	GetReposCommitsByOwnerByRepo           = "GET /repos/{owner}/{repo}/commits"
+	GetReposCommitsByOwnerByRepoByRef      = "GET /repos/{owner}/{repo}/commits/{ref}"
+	GetReposCompareByOwnerByRepoByBasehead = "GET /repos/{owner}/{repo}/compare/{basehead}"
+	GetReposContentsByOwnerByRepoByPath    = "GET /repos/{owner}/{repo}/contents/{path}"
+	PutReposContentsByOwnerByRepoByPath    = "PUT /repos/{owner}/{repo}/contents/{path}"
+	PostReposForksByOwnerByRepo            = "POST /repos/{owner}/{repo}/forks"
+	GetReposSubscriptionByOwnerByRepo      = "GET /repos/{owner}/{repo}/subscription"
+	PutReposSubscriptionByOwnerByRepo      = "PUT /repos/{owner}/{repo}/subscription"
+	DeleteReposSubscriptionByOwnerByRepo   = "DELETE /repos/{owner}/{repo}/subscription"
 
 	// Git endpoints
 	GetReposGitTreesByOwnerByRepoByTree        = "GET /repos/{owner}/{repo}/git/trees/{tree}"
@@ -49,6 +50,9 @@ const (
 	PostReposGitCommitsByOwnerByRepo           = "POST /repos/{owner}/{repo}/git/commits"
 	GetReposGitTagsByOwnerByRepoByTagSHA       = "GET /repos/{owner}/{repo}/git/tags/{tag_sha}"
 	PostReposGitTreesByOwnerByRepo             = "POST /repos/{owner}/{repo}/git/trees"
+	GetReposGitBlobsByOwnerByRepoByFileSHA     = "GET /repos/{owner}/{repo}/git/blobs/{file_sha}"
+	PostReposGitBlobsByOwnerByRepo             = "POST /repos/{owner}/{repo}/git/blobs"
+	DeleteReposGitRefsByOwnerByRepoByRef       = "DELETE /repos/{owner}/{repo}/git/refs/{ref:.*}"
 	GetReposCommitsStatusByOwnerByRepoByRef    = "GET /repos/{owner}/{repo}/commits/{ref}/status"
 	GetReposCommitsStatusesByOwnerByRepoByRef  = "GET /repos/{owner}/{repo}/commits/{ref}/statuses"
 
@@ -66,7 +70,7 @@ const (
 	// Issue dependency endpoints
 	GetReposIssuesDependenciesBlockedByByOwnerByRepoByIssueNumber   = "GET /repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocked_by"
 	GetReposIssuesDependenciesBlockingByOwnerByRepoByIssueNumber     = "GET /repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocking"
-	PostReposIssuesDependenciesBlockedByByOwnerByRepoByIssueNumber  = "POST /repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocked_by"
+	PostReposIssuesDependenciesBlockedByByOwnerByRepoByIssueNumber   = "POST /repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocked_by"
 	DeleteReposIssuesDependenciesBlockedByByOwnerByRepoByIssueNumber = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocked_by"
 	PostReposIssuesDependenciesBlockingByOwnerByRepoByIssueNumber   = "POST /repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocking"
 	DeleteReposIssuesDependenciesBlockingByOwnerByRepoByIssueNumber  = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocking"
@@ -100,28 +104,65 @@ const (
 	PostGists          = "POST /gists"
 	PatchGistsByGistID = "PATCH /gists/{gist_id}"
 
+	// Branch protection and ruleset endpoints
+	GetReposBranchesProtectionByOwnerByRepoByBranch = "GET /repos/{owner}/{repo}/branches/{branch}/protection"
+	GetReposRulesBranchesByOwnerByRepoByBranch      = "GET /repos/{owner}/{repo}/rules/branches/{branch}"
+	GetReposRulesetsByOwnerByRepo                   = "GET /repos/{owner}/{repo}/rulesets"
+	PostReposRulesetsByOwnerByRepo                  = "POST /repos/{owner}/{repo}/rulesets"
+	GetReposRulesetsByOwnerByRepoByRulesetID        = "GET /repos/{owner}/{repo}/rulesets/{ruleset_id}"
+	PutReposRulesetsByOwnerByRepoByRulesetID        = "PUT /repos/{owner}/{repo}/rulesets/{ruleset_id}"
+	GetOrgsRulesetsByOrg                            = "GET /orgs/{org}/rulesets"
+	DeleteOrgsRulesetsByOrgByRulesetID              = "DELETE /orgs/{org}/rulesets/{ruleset_id}"
+
 	// Releases endpoints
-	GetReposReleasesByOwnerByRepo          = "GET /repos/{owner}/{repo}/releases"
-	GetReposReleasesLatestByOwnerByRepo    = "GET /repos/{owner}/{repo}/releases/latest"
-	GetReposReleasesTagsByOwnerByRepoByTag = "GET /repos/{owner}/{repo}/releases/tags/{tag}"
+	GetReposReleasesByOwnerByRepo                   = "GET /repos/{owner}/{repo}/releases"
+	GetReposReleasesLatestByOwnerByRepo             = "GET /repos/{owner}/{repo}/releases/latest"
+	GetReposReleasesTagsByOwnerByRepoByTag          = "GET /repos/{owner}/{repo}/releases/tags/{tag}"
+	PostReposReleasesByOwnerByRepo                  = "POST /repos/{owner}/{repo}/releases"
+	PatchReposReleasesByOwnerByRepoByReleaseID      = "PATCH /repos/{owner}/{repo}/releases/{release_id}"
+	DeleteReposReleasesByOwnerByRepoByReleaseID     = "DELETE /repos/{owner}/{repo}/releases/{release_id}"
+	PostReposReleasesAssetsByOwnerByRepoByReleaseID = "POST /repos/{owner}/{repo}/releases/{release_id}/assets"
+	GetReposReleasesAssetsByOwnerByRepoByAssetID    = "GET /repos/{owner}/{repo}/releases/assets/{asset_id}"
+	DeleteReposReleasesAssetsByOwnerByRepoByAssetID = "DELETE /repos/{owner}/{repo}/releases/assets/{asset_id}"
+	PostReposReleasesGenerateNotesByOwnerByRepo     = "POST /repos/{owner}/{repo}/releases/generate-notes"
 
 	// Code scanning endpoints
-	GetReposCodeScanningAlertsByOwnerByRepo              = "GET /repos/{owner}/{repo}/code-scanning/alerts"
-	GetReposCodeScanningAlertsByOwnerByRepoByAlertNumber = "GET /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}"
+	GetReposCodeScanningAlertsByOwnerByRepo                       = "GET /repos/{owner}/{repo}/code-scanning/alerts"
+	GetReposCodeScanningAlertsByOwnerByRepoByAlertNumber          = "GET /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}"
+	PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber        = "PATCH /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}"
+	GetReposCodeScanningAlertsInstancesByOwnerByRepoByAlertNumber = "GET /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/instances"
+	GetReposCodeScanningAlertsAutofixByOwnerByRepoByAlertNumber   = "GET /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/autofix"
+	PostReposCodeScanningAlertsAutofixByOwnerByRepoByAlertNumber  = "POST /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/autofix"
+	GetReposCodeScanningAnalysesByOwnerByRepo                     = "GET /repos/{owner}/{repo}/code-scanning/analyses"
+	GetReposCodeScanningAnalysesByOwnerByRepoByAnalysisID         = "GET /repos/{owner}/{repo}/code-scanning/analyses/{analysis_id}"
+	PostReposCodeScanningSarifsByOwnerByRepo                      = "POST /repos/{owner}/{repo}/code-scanning/sarifs"
+	GetReposCodeScanningSarifsByOwnerByRepoBySarifID              = "GET /repos/{owner}/{repo}/code-scanning/sarifs/{sarif_id}"
 
 	// Secret scanning endpoints
-	GetReposSecretScanningAlertsByOwnerByRepo              = "GET /repos/{owner}/{repo}/secret-scanning/alerts"                //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
-	GetReposSecretScanningAlertsByOwnerByRepoByAlertNumber = "GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}" //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
+	GetReposSecretScanningAlertsByOwnerByRepo                       = "GET /repos/{owner}/{repo}/secret-scanning/alerts"                          //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
+	GetReposSecretScanningAlertsByOwnerByRepoByAlertNumber          = "GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}"           //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
+	PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber        = "PATCH /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}"         //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
+	GetReposSecretScanningAlertsLocationsByOwnerByRepoByAlertNumber = "GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}/locations" //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
 
 	// Dependabot endpoints
-	GetReposDependabotAlertsByOwnerByRepo              = "GET /repos/{owner}/{repo}/dependabot/alerts"
-	GetReposDependabotAlertsByOwnerByRepoByAlertNumber = "GET /repos/{owner}/{repo}/dependabot/alerts/{alert_number}"
+	GetReposDependabotAlertsByOwnerByRepo                = "GET /repos/{owner}/{repo}/dependabot/alerts"
+	GetReposDependabotAlertsByOwnerByRepoByAlertNumber   = "GET /repos/{owner}/{repo}/dependabot/alerts/{alert_number}"
+	PatchReposDependabotAlertsByOwnerByRepoByAlertNumber = "PATCH /repos/{owner}/{repo}/dependabot/alerts/{alert_number}"
+
+	// Dependency graph endpoints
+	GetReposDependencyGraphSbomByOwnerByRepo              = "GET /repos/{owner}/{repo}/dependency-graph/sbom"
+	GetReposDependencyGraphCompareByOwnerByRepoByBasehead = "GET /repos/{owner}/{repo}/dependency-graph/compare/{basehead}"
+	PostReposDependencyGraphSnapshotsByOwnerByRepo        = "POST /repos/{owner}/{repo}/dependency-graph/snapshots"
 
 	// Security advisories endpoints
-	GetAdvisories                           = "GET /advisories"
-	GetAdvisoriesByGhsaID                   = "GET /advisories/{ghsa_id}"
-	GetReposSecurityAdvisoriesByOwnerByRepo = "GET /repos/{owner}/{repo}/security-advisories"
-	GetOrgsSecurityAdvisoriesByOrg          = "GET /orgs/{org}/security-advisories"
+	GetAdvisories                                         = "GET /advisories"
+	GetAdvisoriesByGhsaID                                 = "GET /advisories/{ghsa_id}"
+	GetReposSecurityAdvisoriesByOwnerByRepo               = "GET /repos/{owner}/{repo}/security-advisories"
+	GetOrgsSecurityAdvisoriesByOrg                        = "GET /orgs/{org}/security-advisories"
+	PostReposSecurityAdvisoriesByOwnerByRepo              = "POST /repos/{owner}/{repo}/security-advisories"
+	PatchReposSecurityAdvisoriesByOwnerByRepoByGhsaID     = "PATCH /repos/{owner}/{repo}/security-advisories/{ghsa_id}"
+	PostReposSecurityAdvisoriesCveByOwnerByRepoByGhsaID   = "POST /repos/{owner}/{repo}/security-advisories/{ghsa_id}/cve"
+	PostReposSecurityAdvisoriesForksByOwnerByRepoByGhsaID = "POST /repos/{owner}/{repo}/security-advisories/{ghsa_id}/forks"
 
 	// Actions endpoints
 	GetReposActionsWorkflowsByOwnerByRepo                        = "GET /repos/{owner}/{repo}/actions/workflows"
@@ -139,6 +180,66 @@ const (
 	PostReposActionsRunsCancelByOwnerByRepoByRunID               = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/cancel"
 	GetReposActionsJobsLogsByOwnerByRepoByJobID                  = "GET /repos/{owner}/{repo}/actions/jobs/{job_id}/logs"
 	DeleteReposActionsRunsLogsByOwnerByRepoByRunID               = "DELETE /repos/{owner}/{repo}/actions/runs/{run_id}/logs"
+	GetReposActionsRunsPendingDeploymentsByOwnerByRepoByRunID    = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/pending_deployments"
+	PostReposActionsRunsPendingDeploymentsByOwnerByRepoByRunID   = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/pending_deployments"
+
+	// Actions variables, secrets and environments endpoints
+	GetReposActionsVariablesByOwnerByRepo                                         = "GET /repos/{owner}/{repo}/actions/variables"
+	PostReposActionsVariablesByOwnerByRepo                                        = "POST /repos/{owner}/{repo}/actions/variables"
+	PatchReposActionsVariablesByOwnerByRepoByName                                 = "PATCH /repos/{owner}/{repo}/actions/variables/{name}"
+	PostOrgsActionsVariablesByOrg                                                 = "POST /orgs/{org}/actions/variables"
+	GetReposEnvironmentsVariablesByOwnerByRepoByEnvironmentNameByName             = "GET /repos/{owner}/{repo}/environments/{environment_name}/variables/{name}"
+	DeleteReposEnvironmentsVariablesByOwnerByRepoByEnvironmentNameByName          = "DELETE /repos/{owner}/{repo}/environments/{environment_name}/variables/{name}"
+	GetOrgsActionsSecretsByOrg                                                    = "GET /orgs/{org}/actions/secrets"
+	GetReposActionsSecretsPublicKeyByOwnerByRepo                                  = "GET /repos/{owner}/{repo}/actions/secrets/public-key"
+	PutReposActionsSecretsByOwnerByRepoBySecretName                               = "PUT /repos/{owner}/{repo}/actions/secrets/{secret_name}"
+	GetOrgsActionsSecretsPublicKeyByOrg                                           = "GET /orgs/{org}/actions/secrets/public-key"
+	PutOrgsActionsSecretsByOrgBySecretName                                        = "PUT /orgs/{org}/actions/secrets/{secret_name}"
+	GetRepositoriesEnvironmentsSecretsByRepositoryIDByEnvironmentName             = "GET /repositories/{repository_id}/environments/{environment_name}/secrets"
+	GetRepositoriesEnvironmentsSecretsPublicKeyByRepositoryIDByEnvironmentName    = "GET /repositories/{repository_id}/environments/{environment_name}/secrets/public-key"
+	PutRepositoriesEnvironmentsSecretsByRepositoryIDByEnvironmentNameBySecretName = "PUT /repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}"
+	DeleteReposActionsSecretsByOwnerByRepoBySecretName                            = "DELETE /repos/{owner}/{repo}/actions/secrets/{secret_name}"
+	GetReposEnvironmentsByOwnerByRepo                                             = "GET /repos/{owner}/{repo}/environments"
+	GetReposEnvironmentsByOwnerByRepoByEnvironmentName                            = "GET /repos/{owner}/{repo}/environments/{environment_name}"
+	GetReposEnvironmentsDeploymentBranchPoliciesByOwnerByRepoByEnvironmentName    = "GET /repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies"
+
+	// Checks endpoints
+	GetReposCommitsCheckSuitesByOwnerByRepoByRef             = "GET /repos/{owner}/{repo}/commits/{ref}/check-suites"
+	GetReposCommitsCheckRunsByOwnerByRepoByRef               = "GET /repos/{owner}/{repo}/commits/{ref}/check-runs"
+	GetReposCheckSuitesCheckRunsByOwnerByRepoByCheckSuiteID  = "GET /repos/{owner}/{repo}/check-suites/{check_suite_id}/check-runs"
+	PostReposCheckSuitesRerequestByOwnerByRepoByCheckSuiteID = "POST /repos/{owner}/{repo}/check-suites/{check_suite_id}/rerequest"
+	GetReposCheckRunsByOwnerByRepoByCheckRunID               = "GET /repos/{owner}/{repo}/check-runs/{check_run_id}"
+	GetReposCheckRunsAnnotationsByOwnerByRepoByCheckRunID    = "GET /repos/{owner}/{repo}/check-runs/{check_run_id}/annotations"
+	PostReposCheckRunsRerequestByOwnerByRepoByCheckRunID     = "POST /repos/{owner}/{repo}/check-runs/{check_run_id}/rerequest"
+
+	// Comments and reactions endpoints
+	GetReposIssuesCommentsByOwnerByRepoByCommentID                        = "GET /repos/{owner}/{repo}/issues/comments/{comment_id}"
+	PatchReposIssuesCommentsByOwnerByRepoByCommentID                      = "PATCH /repos/{owner}/{repo}/issues/comments/{comment_id}"
+	DeleteReposIssuesCommentsByOwnerByRepoByCommentID                     = "DELETE /repos/{owner}/{repo}/issues/comments/{comment_id}"
+	GetReposPullsCommentsByOwnerByRepoByCommentID                         = "GET /repos/{owner}/{repo}/pulls/comments/{comment_id}"
+	PatchReposPullsCommentsByOwnerByRepoByCommentID                       = "PATCH /repos/{owner}/{repo}/pulls/comments/{comment_id}"
+	DeleteReposPullsCommentsByOwnerByRepoByCommentID                      = "DELETE /repos/{owner}/{repo}/pulls/comments/{comment_id}"
+	PostReposIssuesReactionsByOwnerByRepoByIssueNumber                    = "POST /repos/{owner}/{repo}/issues/{issue_number}/reactions"
+	GetReposIssuesReactionsByOwnerByRepoByIssueNumber                     = "GET /repos/{owner}/{repo}/issues/{issue_number}/reactions"
+	DeleteReposIssuesReactionsByOwnerByRepoByIssueNumberByReactionID      = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/reactions/{reaction_id}"
+	PostReposIssuesCommentsReactionsByOwnerByRepoByCommentID              = "POST /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions"
+	PostReposPullsCommentsReactionsByOwnerByRepoByCommentID               = "POST /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions"
+	GetReposPullsCommentsReactionsByOwnerByRepoByCommentID                = "GET /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions"
+	DeleteReposPullsCommentsReactionsByOwnerByRepoByCommentIDByReactionID = "DELETE /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions/{reaction_id}"
+
+	// Milestones endpoints
+	GetReposMilestonesByOwnerByRepo                     = "GET /repos/{owner}/{repo}/milestones"
+	PostReposMilestonesByOwnerByRepo                    = "POST /repos/{owner}/{repo}/milestones"
+	GetReposMilestonesByOwnerByRepoByMilestoneNumber    = "GET /repos/{owner}/{repo}/milestones/{milestone_number}"
+	PatchReposMilestonesByOwnerByRepoByMilestoneNumber  = "PATCH /repos/{owner}/{repo}/milestones/{milestone_number}"
+	DeleteReposMilestonesByOwnerByRepoByMilestoneNumber = "DELETE /repos/{owner}/{repo}/milestones/{milestone_number}"
+
+	// Deployments endpoints
+	GetReposDeploymentsByOwnerByRepo                        = "GET /repos/{owner}/{repo}/deployments"
+	PostReposDeploymentsByOwnerByRepo                       = "POST /repos/{owner}/{repo}/deployments"
+	GetReposDeploymentsByOwnerByRepoByDeploymentID          = "GET /repos/{owner}/{repo}/deployments/{deployment_id}"
+	GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID  = "GET /repos/{owner}/{repo}/deployments/{deployment_id}/statuses"
+	PostReposDeploymentsStatusesByOwnerByRepoByDeploymentID = "POST /repos/{owner}/{repo}/deployments/{deployment_id}/statuses"
 
 	// Search endpoints
 	GetSearchCode         = "GET /search/code"
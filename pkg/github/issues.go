@@ -1256,7 +1256,7 @@ Options are:
 					},
 					"milestone": {
 						Type:        "number",
-						Description: "Milestone number",
+						Description: "Milestone number. Use milestone_read to find milestones.",
 					},
 					"type": {
 						Type:        "string",
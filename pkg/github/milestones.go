@@ -0,0 +1,367 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Method constants for the milestone tools
+const (
+	milestoneMethodList   = "list"
+	milestoneMethodGet    = "get"
+	milestoneMethodCreate = "create"
+	milestoneMethodUpdate = "update"
+	milestoneMethodClose  = "close"
+	milestoneMethodDelete = "delete"
+)
+
+// MilestoneRead returns the tool and handler for listing and getting repository milestones.
+func MilestoneRead(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "milestone_read",
+			Description: t("TOOL_MILESTONE_READ_DESCRIPTION", `Get information about the milestones of a repository, including their open and closed issue counts and due dates.
+Methods:
+- list: list milestones, by default the open ones sorted by due date.
+- get: get a milestone by its number. The milestone number is the one used for the 'milestone' parameter of issue_write.
+`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_MILESTONE_READ_USER_TITLE", "Get repository milestones"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "The method to execute",
+						Enum:        []any{milestoneMethodList, milestoneMethodGet},
+					},
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"milestone_number": {
+						Type:        "number",
+						Description: "The number of the milestone. Required for 'get'.",
+					},
+					"state": {
+						Type:        "string",
+						Description: "Only return milestones in this state. Only used for 'list'.",
+						Enum:        []any{"open", "closed", "all"},
+						Default:     json.RawMessage(`"open"`),
+					},
+					"sort": {
+						Type:        "string",
+						Description: "How to sort the milestones. Only used for 'list'.",
+						Enum:        []any{"due_on", "completeness"},
+						Default:     json.RawMessage(`"due_on"`),
+					},
+					"direction": {
+						Type:        "string",
+						Description: "The sort direction. Only used for 'list'.",
+						Enum:        []any{"asc", "desc"},
+						Default:     json.RawMessage(`"asc"`),
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case milestoneMethodList:
+				return listMilestones(ctx, client, args, owner, repo)
+			case milestoneMethodGet:
+				milestoneNumber, err := RequiredInt(args, "milestone_number")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				milestone, resp, err := client.Issues.GetMilestone(ctx, owner, repo, milestoneNumber)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get milestone", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return MarshalledTextResult(convertToMinimalMilestone(milestone)), nil, nil
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+// MilestoneWrite returns the tool and handler for creating, updating, closing and deleting repository milestones.
+func MilestoneWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "milestone_write",
+			Description: t("TOOL_MILESTONE_WRITE_DESCRIPTION", `Create, update, close or delete repository milestones. To set the milestone of an issue, use issue_write.
+Methods:
+- create: create a milestone.
+- update: change the title, description, due date or state of a milestone.
+- close: close a milestone.
+- delete: delete a milestone. Its issues and pull requests are kept but no longer have a milestone.
+`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_MILESTONE_WRITE_USER_TITLE", "Create, update, close or delete milestones"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "The method to execute",
+						Enum: []any{
+							milestoneMethodCreate,
+							milestoneMethodUpdate,
+							milestoneMethodClose,
+							milestoneMethodDelete,
+						},
+					},
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"milestone_number": {
+						Type:        "number",
+						Description: "The number of the milestone. Required for 'update', 'close' and 'delete'.",
+					},
+					"title": {
+						Type:        "string",
+						Description: "Milestone title. Required for 'create'.",
+					},
+					"description": {
+						Type:        "string",
+						Description: "Milestone description. Used for 'create' and 'update'.",
+					},
+					"due_on": {
+						Type:        "string",
+						Description: "Due date in ISO 8601 format (YYYY-MM-DD or YYYY-MM-DDThh:mm:ssZ). Used for 'create' and 'update'.",
+					},
+					"state": {
+						Type:        "string",
+						Description: "Milestone state. Used for 'create' and 'update'.",
+						Enum:        []any{"open", "closed"},
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case milestoneMethodCreate:
+				return createMilestone(ctx, client, args, owner, repo)
+			case milestoneMethodUpdate:
+				return updateMilestone(ctx, client, args, owner, repo)
+			case milestoneMethodClose:
+				milestoneNumber, err := RequiredInt(args, "milestone_number")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				milestone, resp, err := client.Issues.EditMilestone(ctx, owner, repo, milestoneNumber, &github.Milestone{State: github.Ptr("closed")})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to close milestone", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return MarshalledTextResult(convertToMinimalMilestone(milestone)), nil, nil
+			case milestoneMethodDelete:
+				milestoneNumber, err := RequiredInt(args, "milestone_number")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				resp, err := client.Issues.DeleteMilestone(ctx, owner, repo, milestoneNumber)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete milestone", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return utils.NewToolResultText(fmt.Sprintf("milestone %d deleted successfully", milestoneNumber)), nil, nil
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+func listMilestones(ctx context.Context, client *github.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	state, err := OptionalParam[string](args, "state")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	sort, err := OptionalParam[string](args, "sort")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	direction, err := OptionalParam[string](args, "direction")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	pagination, err := OptionalPaginationParams(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	milestones, resp, err := client.Issues.ListMilestones(ctx, owner, repo, &github.MilestoneListOptions{
+		State:     state,
+		Sort:      sort,
+		Direction: direction,
+		ListOptions: github.ListOptions{
+			Page:    pagination.Page,
+			PerPage: pagination.PerPage,
+		},
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list milestones", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	minimalMilestones := make([]MinimalMilestone, 0, len(milestones))
+	for _, milestone := range milestones {
+		minimalMilestones = append(minimalMilestones, convertToMinimalMilestone(milestone))
+	}
+
+	return MarshalledTextResult(minimalMilestones), nil, nil
+}
+
+// milestoneFromArgs builds the milestone fields given for 'create' and 'update'.
+// It reports whether any field was given.
+func milestoneFromArgs(args map[string]any) (*github.Milestone, bool, error) {
+	title, err := OptionalParam[string](args, "title")
+	if err != nil {
+		return nil, false, err
+	}
+	description, hasDescription, err := OptionalParamOK[string](args, "description")
+	if err != nil {
+		return nil, false, err
+	}
+	dueOn, err := OptionalParam[string](args, "due_on")
+	if err != nil {
+		return nil, false, err
+	}
+	state, err := OptionalParam[string](args, "state")
+	if err != nil {
+		return nil, false, err
+	}
+
+	milestone := &github.Milestone{}
+	changed := false
+	if title != "" {
+		milestone.Title = github.Ptr(title)
+		changed = true
+	}
+	if hasDescription {
+		milestone.Description = github.Ptr(description)
+		changed = true
+	}
+	if dueOn != "" {
+		due, err := parseISOTimestamp(dueOn)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid due_on: %w", err)
+		}
+		milestone.DueOn = &github.Timestamp{Time: due}
+		changed = true
+	}
+	if state != "" {
+		milestone.State = github.Ptr(state)
+		changed = true
+	}
+	return milestone, changed, nil
+}
+
+func createMilestone(ctx context.Context, client *github.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	if _, err := RequiredParam[string](args, "title"); err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	milestone, _, err := milestoneFromArgs(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	created, resp, err := client.Issues.CreateMilestone(ctx, owner, repo, milestone)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create milestone", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToMinimalMilestone(created)), nil, nil
+}
+
+func updateMilestone(ctx context.Context, client *github.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	milestoneNumber, err := RequiredInt(args, "milestone_number")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	milestone, changed, err := milestoneFromArgs(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if !changed {
+		return utils.NewToolResultError("at least one of title, description, due_on or state must be provided for update"), nil, nil
+	}
+
+	updated, resp, err := client.Issues.EditMilestone(ctx, owner, repo, milestoneNumber, milestone)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update milestone", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToMinimalMilestone(updated)), nil, nil
+}
@@ -0,0 +1,211 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MilestoneRead(t *testing.T) {
+	serverTool := MilestoneRead(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "milestone_read", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "milestone_number")
+	assert.Contains(t, schema.Properties, "state")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "list milestones",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposMilestonesByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"state":    "all",
+					"sort":     "completeness",
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(mockResponse(t, http.StatusOK, `[
+					{"number": 3, "title": "v1.2", "state": "open", "open_issues": 4, "closed_issues": 6, "due_on": "2026-11-01T07:00:00Z"}
+				]`)),
+			}),
+			requestArgs:  map[string]any{"method": "list", "owner": "owner", "repo": "repo", "state": "all", "sort": "completeness"},
+			expectedText: `[{"number":3,"title":"v1.2","state":"open","open_issues":4,"closed_issues":6,"due_on":"2026-11-01T07:00:00Z"}]`,
+		},
+		{
+			name: "get milestone",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposMilestonesByOwnerByRepoByMilestoneNumber: mockResponse(t, http.StatusOK, &github.Milestone{
+					Number:       github.Ptr(3),
+					Title:        github.Ptr("v1.2"),
+					State:        github.Ptr("closed"),
+					ClosedIssues: github.Ptr(10),
+				}),
+			}),
+			requestArgs:  map[string]any{"method": "get", "owner": "owner", "repo": "repo", "milestone_number": float64(3)},
+			expectedText: `"closed_issues":10`,
+		},
+		{
+			name:           "get milestone without number",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "get", "owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: milestone_number",
+		},
+		{
+			name: "get missing milestone",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposMilestonesByOwnerByRepoByMilestoneNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs:    map[string]any{"method": "get", "owner": "owner", "repo": "repo", "milestone_number": float64(99)},
+			expectError:    true,
+			expectedErrMsg: "failed to get milestone",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_MilestoneWrite(t *testing.T) {
+	serverTool := MilestoneWrite(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "milestone_write", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.Contains(t, schema.Properties, "due_on")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "create milestone",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposMilestonesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"title":       "v1.3",
+					"description": "Next release",
+					"due_on":      "2026-12-01T00:00:00Z",
+				}).andThen(mockResponse(t, http.StatusCreated, `{"number": 4, "title": "v1.3", "state": "open", "due_on": "2026-12-01T00:00:00Z"}`)),
+			}),
+			requestArgs: map[string]any{
+				"method":      "create",
+				"owner":       "owner",
+				"repo":        "repo",
+				"title":       "v1.3",
+				"description": "Next release",
+				"due_on":      "2026-12-01",
+			},
+			expectedText: `"number":4`,
+		},
+		{
+			name:           "create milestone without title",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "create", "owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: title",
+		},
+		{
+			name:           "create milestone with invalid due date",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "create", "owner": "owner", "repo": "repo", "title": "v1.3", "due_on": "next week"},
+			expectError:    true,
+			expectedErrMsg: "invalid due_on",
+		},
+		{
+			name: "update milestone",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposMilestonesByOwnerByRepoByMilestoneNumber: expectRequestBody(t, map[string]any{
+					"title": "v1.2.1",
+				}).andThen(mockResponse(t, http.StatusOK, `{"number": 3, "title": "v1.2.1", "state": "open"}`)),
+			}),
+			requestArgs:  map[string]any{"method": "update", "owner": "owner", "repo": "repo", "milestone_number": float64(3), "title": "v1.2.1"},
+			expectedText: `"title":"v1.2.1"`,
+		},
+		{
+			name:           "update milestone without changes",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "update", "owner": "owner", "repo": "repo", "milestone_number": float64(3)},
+			expectError:    true,
+			expectedErrMsg: "at least one of title, description, due_on or state must be provided for update",
+		},
+		{
+			name: "close milestone",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposMilestonesByOwnerByRepoByMilestoneNumber: expectRequestBody(t, map[string]any{
+					"state": "closed",
+				}).andThen(mockResponse(t, http.StatusOK, `{"number": 3, "title": "v1.2", "state": "closed", "closed_at": "2026-10-16T12:00:00Z"}`)),
+			}),
+			requestArgs:  map[string]any{"method": "close", "owner": "owner", "repo": "repo", "milestone_number": float64(3)},
+			expectedText: `"state":"closed"`,
+		},
+		{
+			name: "delete milestone",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposMilestonesByOwnerByRepoByMilestoneNumber: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}),
+			requestArgs:  map[string]any{"method": "delete", "owner": "owner", "repo": "repo", "milestone_number": float64(3)},
+			expectedText: "milestone 3 deleted successfully",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
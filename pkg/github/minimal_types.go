@@ -1,6 +1,8 @@
 package github
 
 import (
+	"encoding/json"
+
 	"github.com/google/go-github/v82/github"
 )
 
@@ -88,6 +90,32 @@ type MinimalCommit struct {
 	Files     []MinimalCommitFile `json:"files,omitempty"`
 }
 
+// MinimalComparisonFile represents a file changed between two refs, with its patch
+// if it fitted in the content window.
+type MinimalComparisonFile struct {
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename,omitempty"`
+	Status           string `json:"status,omitempty"`
+	Additions        int    `json:"additions,omitempty"`
+	Deletions        int    `json:"deletions,omitempty"`
+	Changes          int    `json:"changes,omitempty"`
+	Patch            string `json:"patch,omitempty"`
+	PatchTruncated   bool   `json:"patch_truncated,omitempty"`
+}
+
+// MinimalCommitComparison is the trimmed output type for a comparison between two refs.
+type MinimalCommitComparison struct {
+	Status         string                  `json:"status"`
+	AheadBy        int                     `json:"ahead_by"`
+	BehindBy       int                     `json:"behind_by"`
+	TotalCommits   int                     `json:"total_commits"`
+	MergeBaseSHA   string                  `json:"merge_base_sha,omitempty"`
+	HTMLURL        string                  `json:"html_url"`
+	Commits        []MinimalCommit         `json:"commits"`
+	Files          []MinimalComparisonFile `json:"files,omitempty"`
+	NextFileOffset int                     `json:"next_file_offset,omitempty"`
+}
+
 // MinimalRelease is the trimmed output type for release objects.
 type MinimalRelease struct {
 	ID          int64        `json:"id"`
@@ -108,6 +136,162 @@ type MinimalBranch struct {
 	Protected bool   `json:"protected"`
 }
 
+// MinimalCheckSuite is the trimmed output type for check suite objects.
+type MinimalCheckSuite struct {
+	ID         int64  `json:"id"`
+	App        string `json:"app,omitempty"`
+	HeadBranch string `json:"head_branch,omitempty"`
+	HeadSHA    string `json:"head_sha"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+}
+
+// MinimalCheckRun is the trimmed output type for check run objects.
+// ActionsJobID is set when the check run is a GitHub Actions job.
+type MinimalCheckRun struct {
+	ID               int64  `json:"id"`
+	Name             string `json:"name"`
+	App              string `json:"app,omitempty"`
+	HeadSHA          string `json:"head_sha"`
+	Status           string `json:"status"`
+	Conclusion       string `json:"conclusion,omitempty"`
+	Title            string `json:"title,omitempty"`
+	AnnotationsCount int    `json:"annotations_count,omitempty"`
+	CheckSuiteID     int64  `json:"check_suite_id,omitempty"`
+	ActionsJobID     int64  `json:"actions_job_id,omitempty"`
+	StartedAt        string `json:"started_at,omitempty"`
+	CompletedAt      string `json:"completed_at,omitempty"`
+	HTMLURL          string `json:"html_url,omitempty"`
+	DetailsURL       string `json:"details_url,omitempty"`
+}
+
+// MinimalCheckRunAnnotation is the trimmed output type for check run annotations.
+type MinimalCheckRunAnnotation struct {
+	Path       string `json:"path"`
+	StartLine  int    `json:"start_line"`
+	EndLine    int    `json:"end_line"`
+	Level      string `json:"level"`
+	Title      string `json:"title,omitempty"`
+	Message    string `json:"message"`
+	RawDetails string `json:"raw_details,omitempty"`
+}
+
+// CheckRunDetails is the output type for a single check run with its output,
+// annotations and, for GitHub Actions jobs, optionally the job logs.
+type CheckRunDetails struct {
+	MinimalCheckRun
+	Summary     string                      `json:"summary,omitempty"`
+	Text        string                      `json:"text,omitempty"`
+	Annotations []MinimalCheckRunAnnotation `json:"annotations"`
+	Logs        map[string]any              `json:"logs,omitempty"`
+}
+
+// MinimalCommitStatus is the trimmed output type for commit status objects.
+type MinimalCommitStatus struct {
+	Context     string `json:"context"`
+	State       string `json:"state"`
+	Description string `json:"description,omitempty"`
+	TargetURL   string `json:"target_url,omitempty"`
+}
+
+// MinimalCombinedStatus is the trimmed output type for the combined status of a ref.
+type MinimalCombinedStatus struct {
+	State      string                `json:"state"`
+	SHA        string                `json:"sha"`
+	TotalCount int                   `json:"total_count"`
+	Statuses   []MinimalCommitStatus `json:"statuses"`
+}
+
+// MinimalMilestone is the trimmed output type for milestone objects.
+type MinimalMilestone struct {
+	Number       int    `json:"number"`
+	Title        string `json:"title"`
+	Description  string `json:"description,omitempty"`
+	State        string `json:"state"`
+	OpenIssues   int    `json:"open_issues"`
+	ClosedIssues int    `json:"closed_issues"`
+	DueOn        string `json:"due_on,omitempty"`
+	ClosedAt     string `json:"closed_at,omitempty"`
+	HTMLURL      string `json:"html_url,omitempty"`
+}
+
+// MinimalProtectionRule is the trimmed output type for environment protection rules.
+// Reviewers holds user logins and team slugs.
+type MinimalProtectionRule struct {
+	ID                int64    `json:"id"`
+	Type              string   `json:"type"`
+	WaitTimer         int      `json:"wait_timer,omitempty"`
+	PreventSelfReview bool     `json:"prevent_self_review,omitempty"`
+	Reviewers         []string `json:"reviewers,omitempty"`
+}
+
+// MinimalDeploymentBranchPolicy is the trimmed output type for the branch and tag
+// name patterns allowed to deploy to an environment.
+type MinimalDeploymentBranchPolicy struct {
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+// MinimalEnvironment is the trimmed output type for deployment environments.
+type MinimalEnvironment struct {
+	ID                     int64                           `json:"id"`
+	Name                   string                          `json:"name"`
+	HTMLURL                string                          `json:"html_url,omitempty"`
+	CanAdminsBypass        bool                            `json:"can_admins_bypass"`
+	ProtectionRules        []MinimalProtectionRule         `json:"protection_rules,omitempty"`
+	DeploymentBranchPolicy *github.BranchPolicy            `json:"deployment_branch_policy,omitempty"`
+	BranchPolicies         []MinimalDeploymentBranchPolicy `json:"branch_policies,omitempty"`
+}
+
+// MinimalDeploymentStatus is the trimmed output type for deployment status objects.
+type MinimalDeploymentStatus struct {
+	ID             int64  `json:"id"`
+	State          string `json:"state"`
+	Description    string `json:"description,omitempty"`
+	Environment    string `json:"environment,omitempty"`
+	EnvironmentURL string `json:"environment_url,omitempty"`
+	LogURL         string `json:"log_url,omitempty"`
+	Creator        string `json:"creator,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+}
+
+// MinimalDeployment is the trimmed output type for deployment objects.
+type MinimalDeployment struct {
+	ID          int64                     `json:"id"`
+	SHA         string                    `json:"sha"`
+	Ref         string                    `json:"ref"`
+	Task        string                    `json:"task,omitempty"`
+	Environment string                    `json:"environment"`
+	Description string                    `json:"description,omitempty"`
+	Payload     json.RawMessage           `json:"payload,omitempty"`
+	Creator     string                    `json:"creator,omitempty"`
+	CreatedAt   string                    `json:"created_at,omitempty"`
+	Statuses    []MinimalDeploymentStatus `json:"statuses,omitempty"`
+}
+
+// MinimalLiveDeployment summarises what is deployed to an environment: the
+// newest deployment with a successful status, and the newest deployment if it
+// is not that one yet. Incomplete is set when the search for a successful
+// deployment stopped at the status request limit.
+type MinimalLiveDeployment struct {
+	Environment    string             `json:"environment"`
+	Live           *MinimalDeployment `json:"live,omitempty"`
+	Latest         *MinimalDeployment `json:"latest,omitempty"`
+	EnvironmentURL string             `json:"environment_url,omitempty"`
+	Incomplete     bool               `json:"incomplete,omitempty"`
+}
+
+// MinimalPendingDeployment is the trimmed output type for a deployment of a
+// workflow run waiting for review.
+type MinimalPendingDeployment struct {
+	EnvironmentID         int64    `json:"environment_id"`
+	EnvironmentName       string   `json:"environment_name"`
+	WaitTimer             int64    `json:"wait_timer,omitempty"`
+	CurrentUserCanApprove bool     `json:"current_user_can_approve"`
+	Reviewers             []string `json:"reviewers,omitempty"`
+}
+
 // MinimalResponse represents a minimal response for all CRUD operations.
 // Success is implicit in the HTTP response status, and all other information
 // can be derived from the URL or fetched separately if needed.
@@ -252,6 +436,30 @@ func convertToMinimalCommit(commit *github.RepositoryCommit, includeDiffs bool)
 }
 
 // convertToMinimalBranch converts a GitHub API Branch to MinimalBranch
+func convertToMinimalRelease(release *github.RepositoryRelease) MinimalRelease {
+	m := MinimalRelease{
+		ID:         release.GetID(),
+		TagName:    release.GetTagName(),
+		Name:       release.GetName(),
+		Body:       release.GetBody(),
+		HTMLURL:    release.GetHTMLURL(),
+		Prerelease: release.GetPrerelease(),
+		Draft:      release.GetDraft(),
+	}
+	if release.PublishedAt != nil {
+		m.PublishedAt = release.PublishedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if author := release.GetAuthor(); author != nil {
+		m.Author = &MinimalUser{
+			Login:      author.GetLogin(),
+			ID:         author.GetID(),
+			ProfileURL: author.GetHTMLURL(),
+			AvatarURL:  author.GetAvatarURL(),
+		}
+	}
+	return m
+}
+
 func convertToMinimalBranch(branch *github.Branch) MinimalBranch {
 	return MinimalBranch{
 		Name:      branch.GetName(),
@@ -259,3 +467,174 @@ func convertToMinimalBranch(branch *github.Branch) MinimalBranch {
 		Protected: branch.GetProtected(),
 	}
 }
+
+func convertToMinimalCheckSuite(suite *github.CheckSuite) MinimalCheckSuite {
+	m := MinimalCheckSuite{
+		ID:         suite.GetID(),
+		App:        suite.GetApp().GetSlug(),
+		HeadBranch: suite.GetHeadBranch(),
+		HeadSHA:    suite.GetHeadSHA(),
+		Status:     suite.GetStatus(),
+		Conclusion: suite.GetConclusion(),
+	}
+	if suite.UpdatedAt != nil {
+		m.UpdatedAt = suite.UpdatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return m
+}
+
+func convertToMinimalCheckRun(run *github.CheckRun) MinimalCheckRun {
+	m := MinimalCheckRun{
+		ID:               run.GetID(),
+		Name:             run.GetName(),
+		App:              run.GetApp().GetSlug(),
+		HeadSHA:          run.GetHeadSHA(),
+		Status:           run.GetStatus(),
+		Conclusion:       run.GetConclusion(),
+		Title:            run.GetOutput().GetTitle(),
+		AnnotationsCount: run.GetOutput().GetAnnotationsCount(),
+		CheckSuiteID:     run.GetCheckSuite().GetID(),
+		HTMLURL:          run.GetHTMLURL(),
+		DetailsURL:       run.GetDetailsURL(),
+	}
+	if m.App == actionsAppSlug {
+		m.ActionsJobID = run.GetID()
+	}
+	if run.StartedAt != nil {
+		m.StartedAt = run.StartedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if run.CompletedAt != nil {
+		m.CompletedAt = run.CompletedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return m
+}
+
+func convertToMinimalCheckRunAnnotation(annotation *github.CheckRunAnnotation) MinimalCheckRunAnnotation {
+	return MinimalCheckRunAnnotation{
+		Path:       annotation.GetPath(),
+		StartLine:  annotation.GetStartLine(),
+		EndLine:    annotation.GetEndLine(),
+		Level:      annotation.GetAnnotationLevel(),
+		Title:      annotation.GetTitle(),
+		Message:    annotation.GetMessage(),
+		RawDetails: annotation.GetRawDetails(),
+	}
+}
+
+func convertToMinimalCombinedStatus(status *github.CombinedStatus) MinimalCombinedStatus {
+	m := MinimalCombinedStatus{
+		State:      status.GetState(),
+		SHA:        status.GetSHA(),
+		TotalCount: status.GetTotalCount(),
+		Statuses:   make([]MinimalCommitStatus, 0, len(status.Statuses)),
+	}
+	for _, s := range status.Statuses {
+		m.Statuses = append(m.Statuses, MinimalCommitStatus{
+			Context:     s.GetContext(),
+			State:       s.GetState(),
+			Description: s.GetDescription(),
+			TargetURL:   s.GetTargetURL(),
+		})
+	}
+	return m
+}
+
+// requiredReviewerNames returns the logins of the users and the slugs of the teams
+// that can review a deployment.
+func requiredReviewerNames(reviewers []*github.RequiredReviewer) []string {
+	var names []string
+	for _, reviewer := range reviewers {
+		switch r := reviewer.Reviewer.(type) {
+		case *github.User:
+			names = append(names, r.GetLogin())
+		case *github.Team:
+			names = append(names, r.GetSlug())
+		}
+	}
+	return names
+}
+
+func convertToMinimalEnvironment(environment *github.Environment) MinimalEnvironment {
+	m := MinimalEnvironment{
+		ID:                     environment.GetID(),
+		Name:                   environment.GetName(),
+		HTMLURL:                environment.GetHTMLURL(),
+		CanAdminsBypass:        environment.GetCanAdminsBypass(),
+		DeploymentBranchPolicy: environment.DeploymentBranchPolicy,
+	}
+	for _, rule := range environment.ProtectionRules {
+		m.ProtectionRules = append(m.ProtectionRules, MinimalProtectionRule{
+			ID:                rule.GetID(),
+			Type:              rule.GetType(),
+			WaitTimer:         rule.GetWaitTimer(),
+			PreventSelfReview: rule.GetPreventSelfReview(),
+			Reviewers:         requiredReviewerNames(rule.Reviewers),
+		})
+	}
+	return m
+}
+
+func convertToMinimalPendingDeployment(deployment *github.PendingDeployment) MinimalPendingDeployment {
+	return MinimalPendingDeployment{
+		EnvironmentID:         deployment.GetEnvironment().GetID(),
+		EnvironmentName:       deployment.GetEnvironment().GetName(),
+		WaitTimer:             deployment.GetWaitTimer(),
+		CurrentUserCanApprove: deployment.GetCurrentUserCanApprove(),
+		Reviewers:             requiredReviewerNames(deployment.Reviewers),
+	}
+}
+
+func convertToMinimalDeployment(deployment *github.Deployment) MinimalDeployment {
+	m := MinimalDeployment{
+		ID:          deployment.GetID(),
+		SHA:         deployment.GetSHA(),
+		Ref:         deployment.GetRef(),
+		Task:        deployment.GetTask(),
+		Environment: deployment.GetEnvironment(),
+		Description: deployment.GetDescription(),
+		Creator:     deployment.GetCreator().GetLogin(),
+	}
+	// The API returns an empty payload as {} or ""
+	if payload := string(deployment.Payload); payload != "" && payload != "{}" && payload != `""` {
+		m.Payload = deployment.Payload
+	}
+	if deployment.CreatedAt != nil {
+		m.CreatedAt = deployment.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return m
+}
+
+func convertToMinimalDeploymentStatus(status *github.DeploymentStatus) MinimalDeploymentStatus {
+	m := MinimalDeploymentStatus{
+		ID:             status.GetID(),
+		State:          status.GetState(),
+		Description:    status.GetDescription(),
+		Environment:    status.GetEnvironment(),
+		EnvironmentURL: status.GetEnvironmentURL(),
+		LogURL:         status.GetLogURL(),
+		Creator:        status.GetCreator().GetLogin(),
+	}
+	if status.CreatedAt != nil {
+		m.CreatedAt = status.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return m
+}
+
+func convertToMinimalMilestone(milestone *github.Milestone) MinimalMilestone {
+	m := MinimalMilestone{
+		Number:       milestone.GetNumber(),
+		Title:        milestone.GetTitle(),
+		Description:  milestone.GetDescription(),
+		State:        milestone.GetState(),
+		OpenIssues:   milestone.GetOpenIssues(),
+		ClosedIssues: milestone.GetClosedIssues(),
+		HTMLURL:      milestone.GetHTMLURL(),
+	}
+	if milestone.DueOn != nil {
+		m.DueOn = milestone.DueOn.Format("2006-01-02T15:04:05Z")
+	}
+	if milestone.ClosedAt != nil {
+		m.ClosedAt = milestone.ClosedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return m
+}
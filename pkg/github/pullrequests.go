@@ -33,7 +33,7 @@ func PullRequestRead(t translations.TranslationHelperFunc) inventory.ServerTool
 Possible options: 
  1. get - Get details of a specific pull request.
  2. get_diff - Get the diff of a pull request.
- 3. get_status - Get status of a head commit in a pull request. This reflects status of builds and checks.
+ 3. get_status - Get the combined commit status of the head commit in a pull request. Check runs, which GitHub Actions and most CI systems report, are not included; use checks_read with the head SHA to get them.
  4. get_files - Get the list of files changed in a pull request. Use with pagination parameters to control the number of results returned.
  5. get_review_comments - Get review threads on a pull request. Each thread contains logically grouped review comments made on the same code location during pull request reviews. Returns threads with metadata (isResolved, isOutdated, isCollapsed) and their associated comments. Use cursor-based pagination (perPage, after) to control results.
  6. get_reviews - Get the reviews on a pull request. When asked for review comments, use get_review_comments method.
@@ -326,11 +326,12 @@ type reviewThreadNode struct {
 }
 
 type reviewCommentNode struct {
-	ID     githubv4.ID
-	Body   githubv4.String
-	Path   githubv4.String
-	Line   *githubv4.Int
-	Author struct {
+	ID         githubv4.ID
+	DatabaseID githubv4.Int
+	Body       githubv4.String
+	Path       githubv4.String
+	Line       *githubv4.Int
+	Author     struct {
 		Login githubv4.String
 	}
 	CreatedAt githubv4.DateTime
@@ -0,0 +1,261 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Subject types accepted by the reaction_write tool
+const (
+	reactionSubjectIssue                    = "issue"
+	reactionSubjectIssueComment             = "issue_comment"
+	reactionSubjectPullRequestReviewComment = "pull_request_review_comment"
+)
+
+// reactionSubject holds the REST calls for one kind of content that can be reacted to.
+type reactionSubject struct {
+	name   string
+	author func(ctx context.Context) (string, *github.Response, error)
+	create func(ctx context.Context, content string) (*github.Reaction, *github.Response, error)
+	list   func(ctx context.Context, opts *github.ListReactionOptions) ([]*github.Reaction, *github.Response, error)
+	delete func(ctx context.Context, reactionID int64) (*github.Response, error)
+}
+
+// ReactionWrite creates a tool to add or remove reactions on issues, pull requests and their comments.
+func ReactionWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "reaction_write",
+			Description: t("TOOL_REACTION_WRITE_DESCRIPTION", "Add or remove an emoji reaction of the authenticated user on an issue, a pull request, an issue or pull request comment, or a pull request review comment."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_REACTION_WRITE_USER_TITLE", "Add or remove reaction"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The action to perform
+Options are:
+- 'add' - add the reaction. Adding a reaction that already exists has no effect.
+- 'remove' - remove the reaction.
+`,
+						Enum: []any{"add", "remove"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"subject_type": {
+						Type: "string",
+						Description: `What to react to
+Options are:
+- 'issue' - an issue or a pull request, given by issue_number.
+- 'issue_comment' - a comment on an issue or on the conversation of a pull request, given by comment_id.
+- 'pull_request_review_comment' - a review comment on the diff of a pull request, given by comment_id.
+`,
+						Enum: []any{reactionSubjectIssue, reactionSubjectIssueComment, reactionSubjectPullRequestReviewComment},
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "Issue or pull request number. Required when subject_type is 'issue'.",
+					},
+					"comment_id": {
+						Type:        "number",
+						Description: "Comment ID. Required when subject_type is 'issue_comment' or 'pull_request_review_comment'.",
+					},
+					"content": {
+						Type:        "string",
+						Description: "The reaction",
+						Enum:        []any{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"},
+					},
+				},
+				Required: []string{"method", "owner", "repo", "subject_type", "content"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			subjectType, err := RequiredParam[string](args, "subject_type")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			content, err := RequiredParam[string](args, "content")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			subject, err := newReactionSubject(client, args, owner, repo, subjectType)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			switch method {
+			case "add":
+				if deps.GetFlags(ctx).LockdownMode {
+					login, resp, err := subject.author(ctx)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get %s", subject.name), resp, err), nil, nil
+					}
+					_ = resp.Body.Close()
+					result, err := checkLockdownAuthor(ctx, deps, login, owner, repo, subject.name)
+					if result != nil || err != nil {
+						return result, nil, err
+					}
+				}
+
+				reaction, resp, err := subject.create(ctx, content)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to add reaction", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return utils.NewToolResultText(fmt.Sprintf("reaction %s added to %s (reaction ID %d)", content, subject.name, reaction.GetID())), nil, nil
+			case "remove":
+				return removeReaction(ctx, client, subject, content)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		})
+}
+
+func newReactionSubject(client *github.Client, args map[string]any, owner, repo, subjectType string) (*reactionSubject, error) {
+	switch subjectType {
+	case reactionSubjectIssue:
+		issueNumber, err := RequiredInt(args, "issue_number")
+		if err != nil {
+			return nil, err
+		}
+		return &reactionSubject{
+			name: fmt.Sprintf("issue #%d", issueNumber),
+			author: func(ctx context.Context) (string, *github.Response, error) {
+				issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+				return issue.GetUser().GetLogin(), resp, err
+			},
+			create: func(ctx context.Context, content string) (*github.Reaction, *github.Response, error) {
+				return client.Reactions.CreateIssueReaction(ctx, owner, repo, issueNumber, content)
+			},
+			list: func(ctx context.Context, opts *github.ListReactionOptions) ([]*github.Reaction, *github.Response, error) {
+				return client.Reactions.ListIssueReactions(ctx, owner, repo, issueNumber, opts)
+			},
+			delete: func(ctx context.Context, reactionID int64) (*github.Response, error) {
+				return client.Reactions.DeleteIssueReaction(ctx, owner, repo, issueNumber, reactionID)
+			},
+		}, nil
+	case reactionSubjectIssueComment:
+		commentID, err := RequiredBigInt(args, "comment_id")
+		if err != nil {
+			return nil, err
+		}
+		return &reactionSubject{
+			name: fmt.Sprintf("comment %d", commentID),
+			author: func(ctx context.Context) (string, *github.Response, error) {
+				comment, resp, err := client.Issues.GetComment(ctx, owner, repo, commentID)
+				return comment.GetUser().GetLogin(), resp, err
+			},
+			create: func(ctx context.Context, content string) (*github.Reaction, *github.Response, error) {
+				return client.Reactions.CreateIssueCommentReaction(ctx, owner, repo, commentID, content)
+			},
+			list: func(ctx context.Context, opts *github.ListReactionOptions) ([]*github.Reaction, *github.Response, error) {
+				return client.Reactions.ListIssueCommentReactions(ctx, owner, repo, commentID, opts)
+			},
+			delete: func(ctx context.Context, reactionID int64) (*github.Response, error) {
+				return client.Reactions.DeleteIssueCommentReaction(ctx, owner, repo, commentID, reactionID)
+			},
+		}, nil
+	case reactionSubjectPullRequestReviewComment:
+		commentID, err := RequiredBigInt(args, "comment_id")
+		if err != nil {
+			return nil, err
+		}
+		return &reactionSubject{
+			name: fmt.Sprintf("review comment %d", commentID),
+			author: func(ctx context.Context) (string, *github.Response, error) {
+				comment, resp, err := client.PullRequests.GetComment(ctx, owner, repo, commentID)
+				return comment.GetUser().GetLogin(), resp, err
+			},
+			create: func(ctx context.Context, content string) (*github.Reaction, *github.Response, error) {
+				return client.Reactions.CreatePullRequestCommentReaction(ctx, owner, repo, commentID, content)
+			},
+			list: func(ctx context.Context, opts *github.ListReactionOptions) ([]*github.Reaction, *github.Response, error) {
+				return client.Reactions.ListPullRequestCommentReactions(ctx, owner, repo, commentID, opts)
+			},
+			delete: func(ctx context.Context, reactionID int64) (*github.Response, error) {
+				return client.Reactions.DeletePullRequestCommentReaction(ctx, owner, repo, commentID, reactionID)
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown subject_type: %s", subjectType)
+	}
+}
+
+// removeReaction deletes the reaction of the authenticated user with the given
+// content. The API only deletes reactions by ID, so the reaction is looked up first.
+func removeReaction(ctx context.Context, client *github.Client, subject *reactionSubject, content string) (*mcp.CallToolResult, any, error) {
+	user, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get authenticated user", resp, err), nil, nil
+	}
+	_ = resp.Body.Close()
+
+	opts := &github.ListReactionOptions{
+		Content:     content,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		reactions, resp, err := subject.list(ctx, opts)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list reactions", resp, err), nil, nil
+		}
+		_ = resp.Body.Close()
+
+		for _, reaction := range reactions {
+			if reaction.GetUser().GetLogin() != user.GetLogin() {
+				continue
+			}
+			resp, err := subject.delete(ctx, reaction.GetID())
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove reaction", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+			return utils.NewToolResultText(fmt.Sprintf("reaction %s removed from %s", content, subject.name)), nil, nil
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return utils.NewToolResultError(fmt.Sprintf("%s has no %s reaction from %s", subject.name, content, user.GetLogin())), nil, nil
+}
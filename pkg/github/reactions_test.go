@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReactionWrite(t *testing.T) {
+	serverTool := ReactionWrite(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "reaction_write", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "issue_number")
+	assert.Contains(t, schema.Properties, "comment_id")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo", "subject_type", "content"})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]any
+		lockdownEnabled bool
+		expectError     bool
+		expectedErrMsg  string
+		expectedText    string
+	}{
+		{
+			name: "add reaction to issue",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposIssuesReactionsByOwnerByRepoByIssueNumber: expectRequestBody(t, map[string]any{
+					"content": "+1",
+				}).andThen(mockResponse(t, http.StatusCreated, &github.Reaction{ID: github.Ptr(int64(1)), Content: github.Ptr("+1")})),
+			}),
+			requestArgs:  map[string]any{"method": "add", "owner": "owner", "repo": "repo", "subject_type": "issue", "issue_number": float64(42), "content": "+1"},
+			expectedText: "reaction +1 added to issue #42 (reaction ID 1)",
+		},
+		{
+			name: "add reaction to issue comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposIssuesCommentsReactionsByOwnerByRepoByCommentID: expectPath(t, "/repos/owner/repo/issues/comments/123/reactions").andThen(
+					mockResponse(t, http.StatusOK, &github.Reaction{ID: github.Ptr(int64(2)), Content: github.Ptr("eyes")}),
+				),
+			}),
+			requestArgs:  map[string]any{"method": "add", "owner": "owner", "repo": "repo", "subject_type": "issue_comment", "comment_id": float64(123), "content": "eyes"},
+			expectedText: "reaction eyes added to comment 123",
+		},
+		{
+			name:           "comment reaction without comment_id",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "add", "owner": "owner", "repo": "repo", "subject_type": "pull_request_review_comment", "content": "heart"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: comment_id",
+		},
+		{
+			name: "remove own reaction from review comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUser: mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("me")}),
+				GetReposPullsCommentsReactionsByOwnerByRepoByCommentID: expectQueryParams(t, map[string]string{
+					"content":  "rocket",
+					"per_page": "100",
+				}).andThen(mockResponse(t, http.StatusOK, []*github.Reaction{
+					{ID: github.Ptr(int64(10)), Content: github.Ptr("rocket"), User: &github.User{Login: github.Ptr("someone")}},
+					{ID: github.Ptr(int64(11)), Content: github.Ptr("rocket"), User: &github.User{Login: github.Ptr("me")}},
+				})),
+				DeleteReposPullsCommentsReactionsByOwnerByRepoByCommentIDByReactionID: expectPath(t, "/repos/owner/repo/pulls/comments/456/reactions/11").andThen(
+					func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					},
+				),
+			}),
+			requestArgs:  map[string]any{"method": "remove", "owner": "owner", "repo": "repo", "subject_type": "pull_request_review_comment", "comment_id": float64(456), "content": "rocket"},
+			expectedText: "reaction rocket removed from review comment 456",
+		},
+		{
+			name: "remove missing reaction",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUser: mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("me")}),
+				GetReposIssuesReactionsByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, []*github.Reaction{
+					{ID: github.Ptr(int64(10)), Content: github.Ptr("-1"), User: &github.User{Login: github.Ptr("someone")}},
+				}),
+			}),
+			requestArgs:    map[string]any{"method": "remove", "owner": "owner", "repo": "repo", "subject_type": "issue", "issue_number": float64(42), "content": "-1"},
+			expectError:    true,
+			expectedErrMsg: "issue #42 has no -1 reaction from me",
+		},
+		{
+			name: "lockdown restricts issues of users without push access",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, &github.Issue{
+					Number: github.Ptr(42),
+					User:   &github.User{Login: github.Ptr("testuser")},
+				}),
+			}),
+			requestArgs:     map[string]any{"method": "add", "owner": "owner", "repo": "repo", "subject_type": "issue", "issue_number": float64(42), "content": "+1"},
+			lockdownEnabled: true,
+			expectError:     true,
+			expectedErrMsg:  "access to issue #42 is restricted by lockdown mode",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:          github.NewClient(tc.mockedClient),
+				GQLClient:       defaultGQLClient,
+				RepoAccessCache: repoAccessCache,
+				Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": tc.lockdownEnabled}),
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
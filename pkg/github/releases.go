@@ -0,0 +1,543 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxReleaseAssetSize is the largest release asset returned inline by download_release_asset.
+const maxReleaseAssetSize = 1024 * 1024 // 1MB
+
+// ReleaseWrite creates a tool to author releases and their assets.
+func ReleaseWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "release_write",
+			Description: t("TOOL_RELEASE_WRITE_DESCRIPTION", `Create and manage releases in a GitHub repository.
+Methods:
+- create: create a release for a tag, as a draft unless draft is false. The tag is created from target_commitish if it does not exist.
+- update: change the tag, name, notes or flags of a release.
+- publish: publish a draft release.
+- delete: delete a release. The tag is kept.
+- upload_asset: upload a file to a release.
+- delete_asset: delete a release asset.
+- generate_notes: generate release notes for a tag from merged pull requests without creating a release.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_RELEASE_WRITE_USER_TITLE", "Create and manage releases"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "Operation to perform",
+						Enum:        []any{"create", "update", "publish", "delete", "upload_asset", "delete_asset", "generate_notes"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"release_id": {
+						Type:        "number",
+						Description: "Release ID. Required for 'update', 'publish', 'delete' and 'upload_asset'.",
+					},
+					"asset_id": {
+						Type:        "number",
+						Description: "Release asset ID. Required for 'delete_asset'.",
+					},
+					"tag_name": {
+						Type:        "string",
+						Description: "Tag name (e.g., 'v1.0.0'). Required for 'create' and 'generate_notes'.",
+					},
+					"target_commitish": {
+						Type:        "string",
+						Description: "Branch or commit SHA the tag is created from if it does not exist. Defaults to the default branch.",
+					},
+					"previous_tag_name": {
+						Type:        "string",
+						Description: "Tag to generate notes from for 'generate_notes'. Defaults to the previous release.",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Release title",
+					},
+					"body": {
+						Type:        "string",
+						Description: "Release notes in Markdown",
+					},
+					"draft": {
+						Type:        "boolean",
+						Description: "Whether the release is a draft. Defaults to true for 'create'.",
+					},
+					"prerelease": {
+						Type:        "boolean",
+						Description: "Whether the release is a prerelease",
+					},
+					"make_latest": {
+						Type:        "string",
+						Description: "Whether to mark the release as the latest release. 'legacy' uses the creation date and semantic version.",
+						Enum:        []any{"true", "false", "legacy"},
+					},
+					"generate_release_notes": {
+						Type:        "boolean",
+						Description: "For 'create', generate the name and notes automatically. A provided body is prepended to the generated notes.",
+					},
+					"asset_name": {
+						Type:        "string",
+						Description: "File name of the asset. Required for 'upload_asset'.",
+					},
+					"asset_label": {
+						Type:        "string",
+						Description: "Display label of the asset, used instead of the file name",
+					},
+					"content": {
+						Type:        "string",
+						Description: "Asset content. Required for 'upload_asset'.",
+					},
+					"content_encoding": {
+						Type:        "string",
+						Description: "Encoding of content: 'utf-8' for text or 'base64' for binary files",
+						Enum:        []any{"utf-8", "base64"},
+						Default:     json.RawMessage(`"utf-8"`),
+					},
+					"content_type": {
+						Type:        "string",
+						Description: "MIME type of the asset. Detected from the file name if omitted.",
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case "create":
+				result, err := createRelease(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "update":
+				result, err := updateRelease(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "publish":
+				result, err := publishRelease(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "delete":
+				result, err := deleteRelease(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "upload_asset":
+				result, err := uploadReleaseAsset(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "delete_asset":
+				result, err := deleteReleaseAsset(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "generate_notes":
+				result, err := generateReleaseNotes(ctx, client, owner, repo, args)
+				return result, nil, err
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+// releaseFromArgs builds the editable fields of a release from the tool arguments.
+func releaseFromArgs(args map[string]any) (*github.RepositoryRelease, error) {
+	release := &github.RepositoryRelease{}
+	for param, field := range map[string]**string{
+		"tag_name":         &release.TagName,
+		"target_commitish": &release.TargetCommitish,
+		"name":             &release.Name,
+		"body":             &release.Body,
+		"make_latest":      &release.MakeLatest,
+	} {
+		value, ok, err := OptionalParamOK[string](args, param)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			*field = github.Ptr(value)
+		}
+	}
+	for param, field := range map[string]**bool{
+		"draft":      &release.Draft,
+		"prerelease": &release.Prerelease,
+	} {
+		value, ok, err := OptionalParamOK[bool](args, param)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			*field = github.Ptr(value)
+		}
+	}
+	return release, nil
+}
+
+func createRelease(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	if _, err := RequiredParam[string](args, "tag_name"); err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	release, err := releaseFromArgs(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	if release.Draft == nil {
+		release.Draft = github.Ptr(true)
+	}
+	generateNotes, err := OptionalParam[bool](args, "generate_release_notes")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	if generateNotes {
+		release.GenerateReleaseNotes = github.Ptr(true)
+	}
+
+	created, resp, err := client.Repositories.CreateRelease(ctx, owner, repo, release)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create release", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read response body", err), nil
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to create release", resp, body), nil
+	}
+
+	return MarshalledTextResult(convertToMinimalRelease(created)), nil
+}
+
+func updateRelease(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	releaseID, err := RequiredBigInt(args, "release_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	release, err := releaseFromArgs(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	if release.TagName == nil && release.TargetCommitish == nil && release.Name == nil && release.Body == nil &&
+		release.MakeLatest == nil && release.Draft == nil && release.Prerelease == nil {
+		return utils.NewToolResultError("at least one of tag_name, target_commitish, name, body, draft, prerelease or make_latest must be provided for update"), nil
+	}
+	return editRelease(ctx, client, owner, repo, releaseID, release, "failed to update release")
+}
+
+func publishRelease(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	releaseID, err := RequiredBigInt(args, "release_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	release, err := releaseFromArgs(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	release.Draft = github.Ptr(false)
+	return editRelease(ctx, client, owner, repo, releaseID, release, "failed to publish release")
+}
+
+func editRelease(ctx context.Context, client *github.Client, owner, repo string, releaseID int64, release *github.RepositoryRelease, errMsg string) (*mcp.CallToolResult, error) {
+	edited, resp, err := client.Repositories.EditRelease(ctx, owner, repo, releaseID, release)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, errMsg, resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read response body", err), nil
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, errMsg, resp, body), nil
+	}
+
+	return MarshalledTextResult(convertToMinimalRelease(edited)), nil
+}
+
+func deleteRelease(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	releaseID, err := RequiredBigInt(args, "release_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	resp, err := client.Repositories.DeleteRelease(ctx, owner, repo, releaseID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete release", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read response body", err), nil
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to delete release", resp, body), nil
+	}
+
+	return utils.NewToolResultText(fmt.Sprintf("release %d deleted successfully", releaseID)), nil
+}
+
+func uploadReleaseAsset(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	releaseID, err := RequiredBigInt(args, "release_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	assetName, err := RequiredParam[string](args, "asset_name")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	content, err := RequiredParam[string](args, "content")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	encoding, err := OptionalParam[string](args, "content_encoding")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	label, err := OptionalParam[string](args, "asset_label")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	contentType, err := OptionalParam[string](args, "content_type")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	data := []byte(content)
+	switch encoding {
+	case "", "utf-8":
+	case "base64":
+		data, err = base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return utils.NewToolResultError(fmt.Sprintf("content is not valid base64: %s", err)), nil
+		}
+	default:
+		return utils.NewToolResultError(fmt.Sprintf("unsupported content_encoding: %s", encoding)), nil
+	}
+
+	// The path is resolved against the client's upload URL, so uploads go to the
+	// uploads host of the configured GitHub instance
+	release := &github.RepositoryRelease{
+		UploadURL: github.Ptr(fmt.Sprintf("repos/%s/%s/releases/%d/assets", owner, repo, releaseID)),
+	}
+	opts := &github.UploadOptions{
+		Name:      assetName,
+		Label:     label,
+		MediaType: contentType,
+	}
+	asset, resp, err := client.Repositories.UploadReleaseAssetFromRelease(ctx, release, opts, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to upload release asset", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read response body", err), nil
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to upload release asset", resp, body), nil
+	}
+
+	return MarshalledTextResult(MinimalResponse{
+		ID:  fmt.Sprintf("%d", asset.GetID()),
+		URL: asset.GetBrowserDownloadURL(),
+	}), nil
+}
+
+func deleteReleaseAsset(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	assetID, err := RequiredBigInt(args, "asset_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	resp, err := client.Repositories.DeleteReleaseAsset(ctx, owner, repo, assetID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete release asset", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read response body", err), nil
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to delete release asset", resp, body), nil
+	}
+
+	return utils.NewToolResultText(fmt.Sprintf("release asset %d deleted successfully", assetID)), nil
+}
+
+func generateReleaseNotes(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	tagName, err := RequiredParam[string](args, "tag_name")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	opts := &github.GenerateNotesOptions{TagName: tagName}
+	if previous, err := OptionalParam[string](args, "previous_tag_name"); err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	} else if previous != "" {
+		opts.PreviousTagName = github.Ptr(previous)
+	}
+	if target, err := OptionalParam[string](args, "target_commitish"); err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	} else if target != "" {
+		opts.TargetCommitish = github.Ptr(target)
+	}
+
+	notes, resp, err := client.Repositories.GenerateReleaseNotes(ctx, owner, repo, opts)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to generate release notes", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read response body", err), nil
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to generate release notes", resp, body), nil
+	}
+
+	return MarshalledTextResult(notes), nil
+}
+
+// DownloadReleaseAsset creates a tool to download the content of a release asset.
+func DownloadReleaseAsset(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "download_release_asset",
+			Description: t("TOOL_DOWNLOAD_RELEASE_ASSET_DESCRIPTION", "Download a release asset. Text assets are returned as text and binary assets as base64. Assets of 1MB or more are returned as a download link."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_DOWNLOAD_RELEASE_ASSET_USER_TITLE", "Download release asset"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"asset_id": {
+						Type:        "number",
+						Description: "Release asset ID, as listed in the assets of a release",
+					},
+				},
+				Required: []string{"owner", "repo", "asset_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			assetID, err := RequiredBigInt(args, "asset_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			asset, resp, err := client.Repositories.GetReleaseAsset(ctx, owner, repo, assetID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get release asset", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			if asset.GetSize() >= maxReleaseAssetSize {
+				size := int64(asset.GetSize())
+				return utils.NewToolResultResourceLink(
+					fmt.Sprintf("Asset %s is too large to display (%d bytes). Use the download URL to fetch the content: %s", asset.GetName(), asset.GetSize(), asset.GetBrowserDownloadURL()),
+					&mcp.ResourceLink{
+						URI:      asset.GetBrowserDownloadURL(),
+						Name:     asset.GetName(),
+						MIMEType: asset.GetContentType(),
+						Size:     &size,
+					}), nil, nil
+			}
+
+			// Assets are served from a signed URL on another host, which must not receive the token
+			rc, _, err := client.Repositories.DownloadReleaseAsset(ctx, owner, repo, assetID, http.DefaultClient)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to download release asset", err), nil, nil
+			}
+			defer func() { _ = rc.Close() }()
+
+			content, err := io.ReadAll(io.LimitReader(rc, maxReleaseAssetSize))
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to read release asset", err), nil, nil
+			}
+
+			contentType := asset.GetContentType()
+			if contentType == "" || contentType == "application/octet-stream" {
+				contentType = http.DetectContentType(content)
+			}
+			result := &mcp.ResourceContents{
+				URI:      asset.GetBrowserDownloadURL(),
+				MIMEType: contentType,
+			}
+			if utf8.Valid(content) && !strings.Contains(http.DetectContentType(content), "octet-stream") {
+				result.Text = string(content)
+				return utils.NewToolResultResource(fmt.Sprintf("successfully downloaded text asset %s", asset.GetName()), result), nil, nil
+			}
+			result.Blob = content
+			return utils.NewToolResultResource(fmt.Sprintf("successfully downloaded binary asset %s", asset.GetName()), result), nil, nil
+		},
+	)
+}
@@ -0,0 +1,400 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReleaseWrite(t *testing.T) {
+	serverTool := ReleaseWrite(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "release_write", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.Contains(t, schema.Properties, "method")
+	assert.Contains(t, schema.Properties, "release_id")
+	assert.Contains(t, schema.Properties, "content")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo"})
+
+	mockRelease := &github.RepositoryRelease{
+		ID:      github.Ptr(int64(1)),
+		TagName: github.Ptr("v1.0.0"),
+		Name:    github.Ptr("v1.0.0"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/releases/tag/v1.0.0"),
+		Draft:   github.Ptr(true),
+	}
+	publishedRelease := &github.RepositoryRelease{
+		ID:      github.Ptr(int64(1)),
+		TagName: github.Ptr("v1.0.0"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/releases/tag/v1.0.0"),
+		Draft:   github.Ptr(false),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectToolErr  bool
+		expectedErrMsg string
+		expectedText   string
+		expectRelease  *github.RepositoryRelease
+	}{
+		{
+			name: "create defaults to a draft",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposReleasesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"tag_name":               "v1.0.0",
+					"target_commitish":       "main",
+					"draft":                  true,
+					"generate_release_notes": true,
+				}).andThen(mockResponse(t, http.StatusCreated, mockRelease)),
+			}),
+			requestArgs: map[string]any{
+				"method":                 "create",
+				"owner":                  "owner",
+				"repo":                   "repo",
+				"tag_name":               "v1.0.0",
+				"target_commitish":       "main",
+				"generate_release_notes": true,
+			},
+			expectRelease: mockRelease,
+		},
+		{
+			name:         "create without tag_name",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method": "create",
+				"owner":  "owner",
+				"repo":   "repo",
+			},
+			expectToolErr:  true,
+			expectedErrMsg: "missing required parameter: tag_name",
+		},
+		{
+			name: "update release notes",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposReleasesByOwnerByRepoByReleaseID: expectRequestBody(t, map[string]any{
+					"body": "Bug fixes",
+				}).andThen(mockResponse(t, http.StatusOK, mockRelease)),
+			}),
+			requestArgs: map[string]any{
+				"method":     "update",
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(1),
+				"body":       "Bug fixes",
+			},
+			expectRelease: mockRelease,
+		},
+		{
+			name:         "update without changes",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":     "update",
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(1),
+			},
+			expectToolErr:  true,
+			expectedErrMsg: "at least one of",
+		},
+		{
+			name: "publish draft",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposReleasesByOwnerByRepoByReleaseID: expectRequestBody(t, map[string]any{
+					"draft":       false,
+					"make_latest": "true",
+				}).andThen(mockResponse(t, http.StatusOK, publishedRelease)),
+			}),
+			requestArgs: map[string]any{
+				"method":      "publish",
+				"owner":       "owner",
+				"repo":        "repo",
+				"release_id":  float64(1),
+				"make_latest": "true",
+			},
+			expectRelease: publishedRelease,
+		},
+		{
+			name: "publish fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposReleasesByOwnerByRepoByReleaseID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"method":     "publish",
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(1),
+			},
+			expectToolErr:  true,
+			expectedErrMsg: "failed to publish release",
+		},
+		{
+			name: "delete release",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposReleasesByOwnerByRepoByReleaseID: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}),
+			requestArgs: map[string]any{
+				"method":     "delete",
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(1),
+			},
+			expectedText: "release 1 deleted successfully",
+		},
+		{
+			name: "delete asset",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposReleasesAssetsByOwnerByRepoByAssetID: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}),
+			requestArgs: map[string]any{
+				"method":   "delete_asset",
+				"owner":    "owner",
+				"repo":     "repo",
+				"asset_id": float64(7),
+			},
+			expectedText: "release asset 7 deleted successfully",
+		},
+		{
+			name: "generate notes",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposReleasesGenerateNotesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"tag_name":          "v1.1.0",
+					"previous_tag_name": "v1.0.0",
+				}).andThen(mockResponse(t, http.StatusOK, &github.RepositoryReleaseNotes{
+					Name: "v1.1.0",
+					Body: "## What's Changed\n* Fix bug",
+				})),
+			}),
+			requestArgs: map[string]any{
+				"method":            "generate_notes",
+				"owner":             "owner",
+				"repo":              "repo",
+				"tag_name":          "v1.1.0",
+				"previous_tag_name": "v1.0.0",
+			},
+			expectedText: "What's Changed",
+		},
+		{
+			name:         "unknown method",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method": "archive",
+				"owner":  "owner",
+				"repo":   "repo",
+			},
+			expectToolErr:  true,
+			expectedErrMsg: "unknown method: archive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolErr {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			if tc.expectRelease != nil {
+				var returned MinimalRelease
+				require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+				assert.Equal(t, tc.expectRelease.GetID(), returned.ID)
+				assert.Equal(t, tc.expectRelease.GetTagName(), returned.TagName)
+				assert.Equal(t, tc.expectRelease.GetDraft(), returned.Draft)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_ReleaseWrite_UploadAsset(t *testing.T) {
+	serverTool := ReleaseWrite(translations.NullTranslationHelper)
+
+	var uploaded []byte
+	var query, host string
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		PostReposReleasesAssetsByOwnerByRepoByReleaseID: func(w http.ResponseWriter, r *http.Request) {
+			host = r.URL.Host
+			query = r.URL.RawQuery
+			uploaded, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(&github.ReleaseAsset{
+				ID:                 github.Ptr(int64(7)),
+				Name:               github.Ptr("app.bin"),
+				BrowserDownloadURL: github.Ptr("https://github.com/owner/repo/releases/download/v1.0.0/app.bin"),
+			})
+		},
+	})
+	deps := BaseDeps{Client: github.NewClient(mockedClient)}
+	request := createMCPRequest(map[string]any{
+		"method":           "upload_asset",
+		"owner":            "owner",
+		"repo":             "repo",
+		"release_id":       float64(1),
+		"asset_name":       "app.bin",
+		"asset_label":      "App",
+		"content":          "AAEC",
+		"content_encoding": "base64",
+	})
+	result, err := serverTool.Handler(deps)(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+
+	var response MinimalResponse
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, "7", response.ID)
+	assert.Equal(t, "https://github.com/owner/repo/releases/download/v1.0.0/app.bin", response.URL)
+
+	assert.Equal(t, "uploads.github.com", host)
+	assert.Equal(t, "label=App&name=app.bin", query)
+	assert.Equal(t, []byte{0, 1, 2}, uploaded)
+
+	request = createMCPRequest(map[string]any{
+		"method":           "upload_asset",
+		"owner":            "owner",
+		"repo":             "repo",
+		"release_id":       float64(1),
+		"asset_name":       "app.bin",
+		"content":          "not base64!",
+		"content_encoding": "base64",
+	})
+	result, err = serverTool.Handler(deps)(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	assert.Contains(t, getErrorResult(t, result).Text, "content is not valid base64")
+}
+
+func Test_DownloadReleaseAsset(t *testing.T) {
+	serverTool := DownloadReleaseAsset(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "download_release_asset", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "asset_id"})
+
+	downloadURL := "https://github.com/owner/repo/releases/download/v1.0.0/"
+
+	// assetHandler serves asset metadata as JSON and the content as an octet-stream
+	assetHandler := func(asset *github.ReleaseAsset, content []byte) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Accept") == "application/octet-stream" {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(content)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(asset)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		asset        *github.ReleaseAsset
+		content      []byte
+		expectedText string
+		expectBlob   []byte
+		expectLink   bool
+	}{
+		{
+			name: "text asset",
+			asset: &github.ReleaseAsset{
+				ID:                 github.Ptr(int64(7)),
+				Name:               github.Ptr("checksums.txt"),
+				ContentType:        github.Ptr("text/plain"),
+				Size:               github.Ptr(11),
+				BrowserDownloadURL: github.Ptr(downloadURL + "checksums.txt"),
+			},
+			content:      []byte("abc  app.bin"),
+			expectedText: "abc  app.bin",
+		},
+		{
+			name: "binary asset",
+			asset: &github.ReleaseAsset{
+				ID:                 github.Ptr(int64(7)),
+				Name:               github.Ptr("app.bin"),
+				ContentType:        github.Ptr("application/octet-stream"),
+				Size:               github.Ptr(3),
+				BrowserDownloadURL: github.Ptr(downloadURL + "app.bin"),
+			},
+			content:    []byte{0, 1, 2},
+			expectBlob: []byte{0, 1, 2},
+		},
+		{
+			name: "large asset returns a link",
+			asset: &github.ReleaseAsset{
+				ID:                 github.Ptr(int64(7)),
+				Name:               github.Ptr("app.tar.gz"),
+				ContentType:        github.Ptr("application/gzip"),
+				Size:               github.Ptr(5 * 1024 * 1024),
+				BrowserDownloadURL: github.Ptr(downloadURL + "app.tar.gz"),
+			},
+			expectLink: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposReleasesAssetsByOwnerByRepoByAssetID: assetHandler(tc.asset, tc.content),
+			})
+			deps := BaseDeps{Client: github.NewClient(mockedClient)}
+			request := createMCPRequest(map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"asset_id": float64(7),
+			})
+			result, err := serverTool.Handler(deps)(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			require.Len(t, result.Content, 2)
+
+			if tc.expectLink {
+				link, ok := result.Content[1].(*mcp.ResourceLink)
+				require.True(t, ok, "expected a resource link")
+				assert.Equal(t, tc.asset.GetBrowserDownloadURL(), link.URI)
+				return
+			}
+
+			embedded, ok := result.Content[1].(*mcp.EmbeddedResource)
+			require.True(t, ok, "expected an embedded resource")
+			assert.Equal(t, tc.asset.GetBrowserDownloadURL(), embedded.Resource.URI)
+			if tc.expectBlob != nil {
+				assert.Equal(t, tc.expectBlob, embedded.Resource.Blob)
+				return
+			}
+			assert.Equal(t, tc.expectedText, embedded.Resource.Text)
+		})
+	}
+}
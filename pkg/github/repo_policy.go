@@ -0,0 +1,319 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// RepoPolicy restricts which owners and repositories tool calls may target.
+// Read-only tools are checked against Read and all other tools against Write,
+// so an agent can, for example, read anything in an organization but only
+// write to a single repository.
+type RepoPolicy struct {
+	Read  RepoPolicyRule `mapstructure:"read"`
+	Write RepoPolicyRule `mapstructure:"write"`
+}
+
+// RepoPolicyRule is a list of allowed and denied repository patterns.
+//
+// Patterns have the form "owner/repo" and support path.Match wildcards, e.g.
+// "octo-org/*" or "octo-org/service-*". A pattern without a slash matches
+// every repository of that owner. Matching is case-insensitive.
+//
+// Deny patterns take precedence over allow patterns. An empty Allow list
+// allows every repository that is not denied.
+type RepoPolicyRule struct {
+	Allow []string `mapstructure:"allow"`
+	Deny  []string `mapstructure:"deny"`
+}
+
+// repoPattern is a parsed "owner/repo" pattern.
+type repoPattern struct {
+	owner string
+	repo  string
+}
+
+func parseRepoPattern(pattern string) (repoPattern, error) {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	if pattern == "" {
+		return repoPattern{}, errors.New("empty repository pattern")
+	}
+	owner, repo, found := strings.Cut(pattern, "/")
+	if !found {
+		repo = "*"
+	}
+	if owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return repoPattern{}, fmt.Errorf("invalid repository pattern %q: expected owner/repo", pattern)
+	}
+	for _, part := range []string{owner, repo} {
+		if _, err := path.Match(part, ""); err != nil {
+			return repoPattern{}, fmt.Errorf("invalid repository pattern %q: %w", pattern, err)
+		}
+	}
+	return repoPattern{owner: owner, repo: repo}, nil
+}
+
+func (p repoPattern) matchesOwner(owner string) bool {
+	ok, _ := path.Match(p.owner, owner)
+	return ok
+}
+
+func (p repoPattern) matchesRepo(owner, repo string) bool {
+	ok, _ := path.Match(p.repo, repo)
+	return ok && p.matchesOwner(owner)
+}
+
+// Validate checks that all patterns in the policy are well formed.
+func (p *RepoPolicy) Validate() error {
+	var errs []error
+	for name, patterns := range map[string][]string{
+		"read.allow":  p.Read.Allow,
+		"read.deny":   p.Read.Deny,
+		"write.allow": p.Write.Allow,
+		"write.deny":  p.Write.Deny,
+	} {
+		for _, pattern := range patterns {
+			if _, err := parseRepoPattern(pattern); err != nil {
+				errs = append(errs, fmt.Errorf("repo_policy.%s: %w", name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// compiledRepoPolicyRule is a RepoPolicyRule with its patterns parsed.
+type compiledRepoPolicyRule struct {
+	allow []repoPattern
+	deny  []repoPattern
+}
+
+func compileRepoPolicyRule(rule RepoPolicyRule) (compiledRepoPolicyRule, error) {
+	var compiled compiledRepoPolicyRule
+	for _, pattern := range rule.Allow {
+		p, err := parseRepoPattern(pattern)
+		if err != nil {
+			return compiledRepoPolicyRule{}, err
+		}
+		compiled.allow = append(compiled.allow, p)
+	}
+	for _, pattern := range rule.Deny {
+		p, err := parseRepoPattern(pattern)
+		if err != nil {
+			return compiledRepoPolicyRule{}, err
+		}
+		compiled.deny = append(compiled.deny, p)
+	}
+	return compiled, nil
+}
+
+// restricted reports whether the rule limits access at all.
+func (r compiledRepoPolicyRule) restricted() bool {
+	return len(r.allow) > 0 || len(r.deny) > 0
+}
+
+// check returns an error if the target is not permitted by the rule.
+// Targets without a repository are owner-wide: they are denied or allowed
+// only by patterns covering every repository of the owner.
+func (r compiledRepoPolicyRule) check(target repoTarget) error {
+	owner, repo := strings.ToLower(target.owner), strings.ToLower(target.repo)
+
+	for _, p := range r.deny {
+		if repo == "" {
+			if p.repo == "*" && p.matchesOwner(owner) {
+				return fmt.Errorf("owner %s is denied by repository policy", target.owner)
+			}
+			continue
+		}
+		if p.matchesRepo(owner, repo) {
+			return fmt.Errorf("repository %s/%s is denied by repository policy", target.owner, target.repo)
+		}
+	}
+
+	if len(r.allow) == 0 {
+		return nil
+	}
+	for _, p := range r.allow {
+		if repo == "" && p.repo == "*" && p.matchesOwner(owner) {
+			return nil
+		}
+		if repo != "" && p.matchesRepo(owner, repo) {
+			return nil
+		}
+	}
+
+	if repo == "" {
+		return fmt.Errorf("owner %s is not allowed by repository policy", target.owner)
+	}
+	return fmt.Errorf("repository %s/%s is not allowed by repository policy", target.owner, target.repo)
+}
+
+// repoTarget is an owner, or an owner and repository, that a tool call operates on.
+type repoTarget struct {
+	owner string
+	repo  string
+}
+
+// repoArgumentPairs lists the owner/repo argument names used by tools.
+var repoArgumentPairs = [][2]string{
+	{"owner", "repo"},
+	{"item_owner", "item_repo"},
+}
+
+// ownerArguments lists the arguments that name an owner without a repository.
+var ownerArguments = []string{"org", "organization"}
+
+// unscopedSearchTools are the search tools whose results include repository
+// content. When an allowlist is configured, their queries must be scoped to
+// allowed repositories or owners with repo:, org: or user: qualifiers.
+var unscopedSearchTools = map[string]bool{
+	"search_code":          true,
+	"search_issues":        true,
+	"search_pull_requests": true,
+	"search_repositories":  true,
+}
+
+// repoTargetsFromArgs extracts the owners and repositories referenced by the tool arguments,
+// including repo:, org: and user: qualifiers in search queries.
+func repoTargetsFromArgs(toolName string, args map[string]any) []repoTarget {
+	var targets []repoTarget
+
+	for _, pair := range repoArgumentPairs {
+		owner, _ := args[pair[0]].(string)
+		repo, _ := args[pair[1]].(string)
+		if owner != "" {
+			targets = append(targets, repoTarget{owner: owner, repo: repo})
+		}
+	}
+
+	for _, name := range ownerArguments {
+		if owner, _ := args[name].(string); owner != "" {
+			targets = append(targets, repoTarget{owner: owner})
+		}
+	}
+
+	if strings.HasPrefix(toolName, "search_") {
+		if query, _ := args["query"].(string); query != "" {
+			targets = append(targets, repoTargetsFromQuery(query)...)
+		}
+	}
+
+	return targets
+}
+
+// repoTargetsFromQuery extracts repo:, org: and user: qualifiers from a search query.
+// Negated qualifiers (e.g. -repo:owner/name) exclude results and are ignored.
+func repoTargetsFromQuery(query string) []repoTarget {
+	var targets []repoTarget
+	for _, field := range strings.Fields(query) {
+		field = strings.Trim(field, `"()`)
+		qualifier, value, found := strings.Cut(field, ":")
+		if !found || value == "" {
+			continue
+		}
+		switch strings.ToLower(qualifier) {
+		case "repo":
+			owner, repo, ok := strings.Cut(value, "/")
+			if ok && owner != "" && repo != "" {
+				targets = append(targets, repoTarget{owner: owner, repo: repo})
+			}
+		case "org", "user":
+			targets = append(targets, repoTarget{owner: value})
+		}
+	}
+	return targets
+}
+
+// repoTargetFromResourceURI extracts the owner and repository from a repo:// resource URI.
+func repoTargetFromResourceURI(uri string) (repoTarget, bool) {
+	rest, ok := strings.CutPrefix(uri, "repo://")
+	if !ok {
+		return repoTarget{}, false
+	}
+	parts := strings.SplitN(rest, "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return repoTarget{}, false
+	}
+	return repoTarget{owner: parts[0], repo: parts[1]}, true
+}
+
+// RepoPolicyMiddleware returns MCP receiving middleware that rejects tool calls
+// and repository resource reads targeting owners or repositories not permitted
+// by the policy. The inventory is used to determine whether a tool is read-only;
+// tools that are not in the inventory are checked against the write rule.
+// Rejected tool calls return a tool error result so the model can correct its request.
+func RepoPolicyMiddleware(policy RepoPolicy, inv *inventory.Inventory) (mcp.Middleware, error) {
+	readRule, err := compileRepoPolicyRule(policy.Read)
+	if err != nil {
+		return nil, fmt.Errorf("invalid read rule: %w", err)
+	}
+	writeRule, err := compileRepoPolicyRule(policy.Write)
+	if err != nil {
+		return nil, fmt.Errorf("invalid write rule: %w", err)
+	}
+
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, request mcp.Request) (mcp.Result, error) {
+			switch req := request.(type) {
+			case *mcp.CallToolRequest:
+				if method != inventory.MCPMethodToolsCall || req.Params == nil {
+					break
+				}
+				rule, write := writeRule, true
+				if tool, _, err := inv.FindToolByName(req.Params.Name); err == nil && tool.IsReadOnly() {
+					rule, write = readRule, false
+				}
+				if !rule.restricted() {
+					break
+				}
+				if err := checkToolCall(rule, req.Params.Name, req.Params.Arguments, write); err != nil {
+					return utils.NewToolResultError(err.Error()), nil
+				}
+			case *mcp.ReadResourceRequest:
+				if method != inventory.MCPMethodResourcesRead || req.Params == nil {
+					break
+				}
+				if target, ok := repoTargetFromResourceURI(req.Params.URI); ok {
+					if err := readRule.check(target); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return next(ctx, method, request)
+		}
+	}, nil
+}
+
+// checkToolCall checks the arguments of a tool call against the rule. When an
+// allowlist is configured, write calls must name at least one target.
+func checkToolCall(rule compiledRepoPolicyRule, toolName string, rawArgs json.RawMessage, write bool) error {
+	var args map[string]any
+	if len(rawArgs) > 0 {
+		if err := json.Unmarshal(rawArgs, &args); err != nil {
+			// Malformed arguments are reported by the tool handler itself
+			return nil
+		}
+	}
+
+	targets := repoTargetsFromArgs(toolName, args)
+	if len(targets) == 0 && len(rule.allow) > 0 && unscopedSearchTools[toolName] {
+		return fmt.Errorf("repository policy requires %s queries to be scoped with a repo:, org: or user: qualifier", toolName)
+	}
+	if len(targets) == 0 && len(rule.allow) > 0 && write {
+		return fmt.Errorf("repository policy requires %s calls to target an allowed repository", toolName)
+	}
+
+	for _, target := range targets {
+		if err := rule.check(target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      RepoPolicy
+		expectedErr string
+	}{
+		{
+			name: "valid patterns",
+			policy: RepoPolicy{
+				Read:  RepoPolicyRule{Allow: []string{"octo-org/*", "octocat"}, Deny: []string{"octo-org/secret-*"}},
+				Write: RepoPolicyRule{Allow: []string{"octo-org/sandbox"}},
+			},
+		},
+		{
+			name:        "too many segments",
+			policy:      RepoPolicy{Read: RepoPolicyRule{Allow: []string{"octo-org/repo/extra"}}},
+			expectedErr: "repo_policy.read.allow",
+		},
+		{
+			name:        "malformed glob",
+			policy:      RepoPolicy{Write: RepoPolicyRule{Deny: []string{"octo-org/[repo"}}},
+			expectedErr: "repo_policy.write.deny",
+		},
+		{
+			name:        "empty pattern",
+			policy:      RepoPolicy{Write: RepoPolicyRule{Allow: []string{""}}},
+			expectedErr: "empty repository pattern",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+func TestRepoPolicyRuleCheck(t *testing.T) {
+	rule, err := compileRepoPolicyRule(RepoPolicyRule{
+		Allow: []string{"octo-org/*", "octocat/hello-world"},
+		Deny:  []string{"octo-org/secret-*", "evil-org"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		target  repoTarget
+		allowed bool
+	}{
+		{name: "allowed by owner wildcard", target: repoTarget{owner: "octo-org", repo: "api"}, allowed: true},
+		{name: "case insensitive", target: repoTarget{owner: "Octo-Org", repo: "API"}, allowed: true},
+		{name: "allowed exact repo", target: repoTarget{owner: "octocat", repo: "hello-world"}, allowed: true},
+		{name: "other repo of partially allowed owner", target: repoTarget{owner: "octocat", repo: "spoon-knife"}, allowed: false},
+		{name: "deny takes precedence", target: repoTarget{owner: "octo-org", repo: "secret-keys"}, allowed: false},
+		{name: "not in allowlist", target: repoTarget{owner: "other", repo: "repo"}, allowed: false},
+		{name: "owner with some allowed repos", target: repoTarget{owner: "octocat"}, allowed: false},
+		{name: "owner with all repos allowed", target: repoTarget{owner: "octo-org"}, allowed: true},
+		{name: "owner not partially denied", target: repoTarget{owner: "octo-org"}, allowed: true},
+		{name: "owner fully denied", target: repoTarget{owner: "evil-org"}, allowed: false},
+		{name: "owner not allowed", target: repoTarget{owner: "other"}, allowed: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := rule.check(tc.target)
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRepoTargetsFromArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		toolName string
+		args     map[string]any
+		expected []repoTarget
+	}{
+		{
+			name:     "owner and repo",
+			toolName: "get_file_contents",
+			args:     map[string]any{"owner": "octo-org", "repo": "api", "path": "README.md"},
+			expected: []repoTarget{{owner: "octo-org", repo: "api"}},
+		},
+		{
+			name:     "owner only",
+			toolName: "projects_list",
+			args:     map[string]any{"owner": "octo-org"},
+			expected: []repoTarget{{owner: "octo-org"}},
+		},
+		{
+			name:     "project item repository",
+			toolName: "projects_write",
+			args:     map[string]any{"org": "octo-org", "item_owner": "octocat", "item_repo": "hello-world"},
+			expected: []repoTarget{{owner: "octocat", repo: "hello-world"}, {owner: "octo-org"}},
+		},
+		{
+			name:     "search qualifiers",
+			toolName: "search_code",
+			args:     map[string]any{"query": "func repo:octo-org/api org:octocat -repo:octo-org/excluded user:monalisa"},
+			expected: []repoTarget{{owner: "octo-org", repo: "api"}, {owner: "octocat"}, {owner: "monalisa"}},
+		},
+		{
+			name:     "query ignored for non-search tools",
+			toolName: "create_issue",
+			args:     map[string]any{"query": "repo:octo-org/api"},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, repoTargetsFromArgs(tc.toolName, tc.args))
+		})
+	}
+}
+
+func TestRepoPolicyMiddleware(t *testing.T) {
+	readTool := inventory.ServerTool{
+		Tool:    mcp.Tool{Name: "get_file_contents", Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true}},
+		Toolset: ToolsetMetadataRepos,
+	}
+	searchTool := inventory.ServerTool{
+		Tool:    mcp.Tool{Name: "search_code", Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true}},
+		Toolset: ToolsetMetadataRepos,
+	}
+	writeTool := inventory.ServerTool{
+		Tool:    mcp.Tool{Name: "create_or_update_file"},
+		Toolset: ToolsetMetadataRepos,
+	}
+	inv, err := inventory.NewBuilder().SetTools([]inventory.ServerTool{readTool, searchTool, writeTool}).Build()
+	require.NoError(t, err)
+
+	middleware, err := RepoPolicyMiddleware(RepoPolicy{
+		Read:  RepoPolicyRule{Allow: []string{"octo-org/*", "octocat/hello-world"}},
+		Write: RepoPolicyRule{Allow: []string{"octo-org/sandbox"}},
+	}, inv)
+	require.NoError(t, err)
+
+	called := false
+	handler := middleware(func(_ context.Context, _ string, _ mcp.Request) (mcp.Result, error) {
+		called = true
+		return &mcp.CallToolResult{}, nil
+	})
+
+	callTool := func(t *testing.T, name string, args map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		called = false
+		raw, err := json.Marshal(args)
+		require.NoError(t, err)
+		result, err := handler(context.Background(), inventory.MCPMethodToolsCall, &mcp.CallToolRequest{
+			Params: &mcp.CallToolParamsRaw{Name: name, Arguments: raw},
+		})
+		require.NoError(t, err)
+		return result.(*mcp.CallToolResult)
+	}
+
+	tests := []struct {
+		name        string
+		tool        string
+		args        map[string]any
+		allowed     bool
+		expectedErr string
+	}{
+		{name: "read in allowed org", tool: "get_file_contents", args: map[string]any{"owner": "octo-org", "repo": "api"}, allowed: true},
+		{name: "read outside allowlist", tool: "get_file_contents", args: map[string]any{"owner": "other", "repo": "api"}, expectedErr: "repository other/api is not allowed"},
+		{name: "write to allowed repo", tool: "create_or_update_file", args: map[string]any{"owner": "octo-org", "repo": "sandbox"}, allowed: true},
+		{name: "write to read-only repo", tool: "create_or_update_file", args: map[string]any{"owner": "octo-org", "repo": "api"}, expectedErr: "repository octo-org/api is not allowed"},
+		{name: "scoped search", tool: "search_code", args: map[string]any{"query": "func repo:octo-org/api"}, allowed: true},
+		{name: "search outside allowlist", tool: "search_code", args: map[string]any{"query": "func org:other"}, expectedErr: "owner other is not allowed"},
+		{name: "search in fully allowed org", tool: "search_code", args: map[string]any{"query": "func org:octo-org"}, allowed: true},
+		{name: "search in partially allowed owner", tool: "search_code", args: map[string]any{"query": "func user:octocat"}, expectedErr: "owner octocat is not allowed"},
+		{name: "org-level write with single repo allowlist", tool: "create_or_update_file", args: map[string]any{"owner": "octo-org"}, expectedErr: "owner octo-org is not allowed"},
+		{name: "write without target", tool: "create_or_update_file", args: map[string]any{}, expectedErr: "requires create_or_update_file calls to target an allowed repository"},
+		{name: "unscoped search", tool: "search_code", args: map[string]any{"query": "func"}, expectedErr: "scoped with a repo:, org: or user: qualifier"},
+		{name: "unknown tool uses write rule", tool: "not_in_inventory", args: map[string]any{"owner": "octo-org", "repo": "api"}, expectedErr: "repository octo-org/api is not allowed"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := callTool(t, tc.tool, tc.args)
+			if tc.allowed {
+				assert.True(t, called)
+				assert.False(t, result.IsError)
+				return
+			}
+			assert.False(t, called)
+			require.True(t, result.IsError)
+			assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErr)
+		})
+	}
+
+	t.Run("resource read outside allowlist", func(t *testing.T) {
+		called = false
+		_, err := handler(context.Background(), inventory.MCPMethodResourcesRead, &mcp.ReadResourceRequest{
+			Params: &mcp.ReadResourceParams{URI: "repo://other/api/contents/README.md"},
+		})
+		require.Error(t, err)
+		assert.False(t, called)
+	})
+
+	t.Run("resource read in allowed org", func(t *testing.T) {
+		called = false
+		_, err := handler(context.Background(), inventory.MCPMethodResourcesRead, &mcp.ReadResourceRequest{
+			Params: &mcp.ReadResourceParams{URI: "repo://octo-org/api/contents/README.md"},
+		})
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+}
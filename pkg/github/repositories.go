@@ -21,6 +21,10 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+// defaultContentWindowSize is the number of lines of patch content returned when no
+// content window size is configured, matching the --content-window-size default.
+const defaultContentWindowSize = 5000
+
 func GetCommit(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
 		ToolsetMetadataRepos,
@@ -226,6 +230,179 @@ func ListCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 	)
 }
 
+// CompareRefs creates a tool to compare two commits, branches or tags in a repository.
+func CompareRefs(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "compare_refs",
+			Description: t("TOOL_COMPARE_REFS_DESCRIPTION", `Compare two commits, branches or tags in a GitHub repository (base...head).
+Returns how far head is ahead of and behind base, the commits in head that are not in base, and the changed files.
+File patches are included until the content window is full. If next_file_offset is set, call again with file_offset set to it to get the patches of the remaining files.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_COMPARE_REFS_USER_TITLE", "Compare refs"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"base": {
+						Type:        "string",
+						Description: "Commit SHA, branch or tag name to compare from. Use 'owner:branch' to compare with a branch in a fork.",
+					},
+					"head": {
+						Type:        "string",
+						Description: "Commit SHA, branch or tag name to compare to. Use 'owner:branch' to compare with a branch in a fork.",
+					},
+					"include_patches": {
+						Type:        "boolean",
+						Description: "Whether to include file patches in the response. Default is true.",
+						Default:     json.RawMessage(`true`),
+					},
+					"file_offset": {
+						Type:        "number",
+						Description: "Index of the first changed file to include a patch for. Use next_file_offset from a previous response. Default is 0.",
+						Minimum:     jsonschema.Ptr(0.0),
+					},
+				},
+				Required: []string{"owner", "repo", "base", "head"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			base, err := RequiredParam[string](args, "base")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			head, err := RequiredParam[string](args, "head")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includePatches, err := OptionalBoolParamWithDefault(args, "include_patches", true)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			fileOffset, err := OptionalIntParam(args, "file_offset")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if fileOffset < 0 {
+				return utils.NewToolResultError("file_offset must be 0 or greater"), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to compare %s...%s", base, head),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to compare refs", resp, body), nil, nil
+			}
+
+			minimalComparison := MinimalCommitComparison{
+				Status:       comparison.GetStatus(),
+				AheadBy:      comparison.GetAheadBy(),
+				BehindBy:     comparison.GetBehindBy(),
+				TotalCommits: comparison.GetTotalCommits(),
+				MergeBaseSHA: comparison.GetMergeBaseCommit().GetSHA(),
+				HTMLURL:      comparison.GetHTMLURL(),
+				Commits:      make([]MinimalCommit, len(comparison.Commits)),
+			}
+			for i, commit := range comparison.Commits {
+				minimalComparison.Commits[i] = convertToMinimalCommit(commit, false)
+			}
+			minimalComparison.Files, minimalComparison.NextFileOffset = comparisonFiles(comparison.Files, includePatches, fileOffset, deps.GetContentWindowSize())
+
+			r, err := json.Marshal(minimalComparison)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// comparisonFiles converts the changed files of a comparison, adding the patches of
+// files from fileOffset onwards until they would exceed maxPatchLines in total. A patch
+// that exceeds the window on its own is truncated. It returns the offset of the first
+// file whose patch was left out, or 0 if every remaining patch fitted.
+func comparisonFiles(files []*github.CommitFile, includePatches bool, fileOffset int, maxPatchLines int) ([]MinimalComparisonFile, int) {
+	if maxPatchLines <= 0 {
+		maxPatchLines = defaultContentWindowSize
+	}
+
+	result := make([]MinimalComparisonFile, len(files))
+	nextFileOffset := 0
+	remaining := maxPatchLines
+	for i, file := range files {
+		result[i] = MinimalComparisonFile{
+			Filename:         file.GetFilename(),
+			PreviousFilename: file.GetPreviousFilename(),
+			Status:           file.GetStatus(),
+			Additions:        file.GetAdditions(),
+			Deletions:        file.GetDeletions(),
+			Changes:          file.GetChanges(),
+		}
+		if !includePatches || i < fileOffset || nextFileOffset > 0 || file.GetPatch() == "" {
+			continue
+		}
+
+		lines := strings.Split(file.GetPatch(), "\n")
+		switch {
+		case len(lines) <= remaining:
+			result[i].Patch = file.GetPatch()
+			remaining -= len(lines)
+		case remaining == maxPatchLines:
+			// The first patch in the window always gets in, so every call makes progress
+			result[i].Patch = strings.Join(lines[:remaining], "\n")
+			result[i].PatchTruncated = true
+			remaining = 0
+		default:
+			nextFileOffset = i
+		}
+	}
+	return result, nextFileOffset
+}
+
 // ListBranches creates a tool to list branches in a GitHub repository.
 func ListBranches(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
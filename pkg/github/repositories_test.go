@@ -1075,6 +1075,169 @@ func Test_ListCommits(t *testing.T) {
 	}
 }
 
+func Test_CompareRefs(t *testing.T) {
+	serverTool := CompareRefs(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "compare_refs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, schema.Properties, "base")
+	assert.Contains(t, schema.Properties, "head")
+	assert.Contains(t, schema.Properties, "include_patches")
+	assert.Contains(t, schema.Properties, "file_offset")
+	assert.Contains(t, schema.Properties, "page")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "base", "head"})
+
+	mockComparison := &github.CommitsComparison{
+		Status:          github.Ptr("ahead"),
+		AheadBy:         github.Ptr(2),
+		BehindBy:        github.Ptr(0),
+		TotalCommits:    github.Ptr(2),
+		HTMLURL:         github.Ptr("https://github.com/owner/repo/compare/v1.2...main"),
+		MergeBaseCommit: &github.RepositoryCommit{SHA: github.Ptr("base123")},
+		Commits: []*github.RepositoryCommit{
+			{SHA: github.Ptr("abc123"), Commit: &github.Commit{Message: github.Ptr("Add feature")}},
+			{SHA: github.Ptr("def456"), Commit: &github.Commit{Message: github.Ptr("Fix bug")}},
+		},
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("a.go"), Status: github.Ptr("modified"), Changes: github.Ptr(2), Patch: github.Ptr("@@ -1 +1 @@\n-old\n+new")},
+			{Filename: github.Ptr("b.go"), Status: github.Ptr("added"), Changes: github.Ptr(2), Patch: github.Ptr("@@ -0,0 +1,2 @@\n+one\n+two")},
+			{Filename: github.Ptr("c.go"), PreviousFilename: github.Ptr("old.go"), Status: github.Ptr("renamed"), Patch: github.Ptr("@@ -1 +1 @@\n-x\n+y")},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		contentWindowSize int
+		requestArgs       map[string]any
+		mockedClient      *http.Client
+		expectError       bool
+		expectedErrMsg    string
+		expectedPatches   []string
+		expectedTruncated []bool
+		expectedNext      int
+	}{
+		{
+			name: "all patches fit",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCompareByOwnerByRepoByBasehead: expectPath(t, "/repos/owner/repo/compare/v1.2...main").andThen(
+					mockResponse(t, http.StatusOK, mockComparison),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v1.2",
+				"head":  "main",
+			},
+			expectedPatches:   []string{"@@ -1 +1 @@\n-old\n+new", "@@ -0,0 +1,2 @@\n+one\n+two", "@@ -1 +1 @@\n-x\n+y"},
+			expectedTruncated: []bool{false, false, false},
+		},
+		{
+			name:              "patches beyond the content window are left for the next call",
+			contentWindowSize: 5,
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCompareByOwnerByRepoByBasehead: mockResponse(t, http.StatusOK, mockComparison),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v1.2",
+				"head":  "main",
+			},
+			expectedPatches:   []string{"@@ -1 +1 @@\n-old\n+new", "", ""},
+			expectedTruncated: []bool{false, false, false},
+			expectedNext:      1,
+		},
+		{
+			name:              "file offset with a patch larger than the window",
+			contentWindowSize: 2,
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCompareByOwnerByRepoByBasehead: mockResponse(t, http.StatusOK, mockComparison),
+			}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"base":        "v1.2",
+				"head":        "main",
+				"file_offset": float64(1),
+			},
+			expectedPatches:   []string{"", "@@ -0,0 +1,2 @@\n+one", ""},
+			expectedTruncated: []bool{false, true, false},
+			expectedNext:      2,
+		},
+		{
+			name: "without patches",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCompareByOwnerByRepoByBasehead: mockResponse(t, http.StatusOK, mockComparison),
+			}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"base":            "v1.2",
+				"head":            "main",
+				"include_patches": false,
+			},
+			expectedPatches:   []string{"", "", ""},
+			expectedTruncated: []bool{false, false, false},
+		},
+		{
+			name: "comparison fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCompareByOwnerByRepoByBasehead: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v1.2",
+				"head":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to compare v1.2...missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:            github.NewClient(tc.mockedClient),
+				ContentWindowSize: tc.contentWindowSize,
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var comparison MinimalCommitComparison
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &comparison))
+			assert.Equal(t, "ahead", comparison.Status)
+			assert.Equal(t, 2, comparison.AheadBy)
+			assert.Equal(t, 0, comparison.BehindBy)
+			assert.Equal(t, "base123", comparison.MergeBaseSHA)
+			require.Len(t, comparison.Commits, 2)
+			assert.Equal(t, "abc123", comparison.Commits[0].SHA)
+			assert.Equal(t, "Fix bug", comparison.Commits[1].Commit.Message)
+
+			require.Len(t, comparison.Files, 3)
+			assert.Equal(t, "old.go", comparison.Files[2].PreviousFilename)
+			for i, file := range comparison.Files {
+				assert.Equal(t, tc.expectedPatches[i], file.Patch, file.Filename)
+				assert.Equal(t, tc.expectedTruncated[i], file.PatchTruncated, file.Filename)
+			}
+			assert.Equal(t, tc.expectedNext, comparison.NextFileOffset)
+		})
+	}
+}
+
 func Test_CreateOrUpdateFile(t *testing.T) {
 	// Verify tool definition once
 	serverTool := CreateOrUpdateFile(translations.NullTranslationHelper)
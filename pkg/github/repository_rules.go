@@ -0,0 +1,475 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// RepositoryRulesRead creates a tool to read the branch protection and rulesets that apply to a repository.
+func RepositoryRulesRead(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "repository_rules_read",
+			Description: t("TOOL_REPOSITORY_RULES_READ_DESCRIPTION", `Read the branch protection and rulesets of a repository or organization, for example to find out why a pull request cannot be merged or a push is rejected.
+Methods:
+- get_rules_for_branch: get every active rule that applies to a branch, from repository and organization rulesets, with the ruleset each rule comes from.
+- get_branch_protection: get the classic branch protection settings of a branch.
+- list_rulesets: list the rulesets of a repository, or of an organization if repo is omitted.
+- get_ruleset: get a ruleset with its conditions, rules and bypass actors.
+Reading branch protection and organization rulesets requires admin access.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_REPOSITORY_RULES_READ_USER_TITLE", "Read branch protection and rulesets"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "Operation to perform",
+						Enum:        []any{"get_rules_for_branch", "get_branch_protection", "list_rulesets", "get_ruleset"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner, or the organization for organization rulesets",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name. Omit for organization rulesets. Required for 'get_rules_for_branch' and 'get_branch_protection'.",
+					},
+					"branch": {
+						Type:        "string",
+						Description: "Branch name. Required for 'get_rules_for_branch' and 'get_branch_protection'.",
+					},
+					"ruleset_id": {
+						Type:        "number",
+						Description: "Ruleset ID. Required for 'get_ruleset'.",
+					},
+					"includes_parents": {
+						Type:        "boolean",
+						Description: "For repository rulesets, include rulesets configured at the organization or enterprise level that apply to the repository. Default is true.",
+						Default:     json.RawMessage(`true`),
+					},
+				},
+				Required: []string{"method", "owner"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := OptionalParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case "get_rules_for_branch":
+				result, err := getRulesForBranch(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "get_branch_protection":
+				result, err := getBranchProtection(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "list_rulesets":
+				result, err := listRulesets(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "get_ruleset":
+				result, err := getRuleset(ctx, client, owner, repo, args)
+				return result, nil, err
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+func getRulesForBranch(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	if repo == "" {
+		return utils.NewToolResultError("missing required parameter: repo"), nil
+	}
+	branch, err := RequiredParam[string](args, "branch")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	pagination, err := OptionalPaginationParams(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	// github.BranchRules groups rules by type and does not marshal back to the API
+	// format, so the rules are decoded as returned, each with its ruleset source.
+	path := fmt.Sprintf("repos/%s/%s/rules/branches/%s?page=%d&per_page=%d", owner, repo, branch, pagination.Page, pagination.PerPage)
+	req, err := client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	var rules []map[string]any
+	resp, err := client.Do(ctx, req, &rules)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get rules for branch", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read response body", err), nil
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get rules for branch", resp, body), nil
+	}
+
+	if rules == nil {
+		rules = []map[string]any{}
+	}
+	return MarshalledTextResult(rules), nil
+}
+
+func getBranchProtection(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	if repo == "" {
+		return utils.NewToolResultError("missing required parameter: repo"), nil
+	}
+	branch, err := RequiredParam[string](args, "branch")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	protection, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+	if errors.Is(err, github.ErrBranchNotProtected) {
+		return utils.NewToolResultText(fmt.Sprintf("branch %s has no classic branch protection. Use get_rules_for_branch to check for rulesets.", branch)), nil
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get branch protection", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(protection), nil
+}
+
+func listRulesets(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	pagination, err := OptionalPaginationParams(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	listOptions := github.ListOptions{
+		Page:    pagination.Page,
+		PerPage: pagination.PerPage,
+	}
+
+	var rulesets []*github.RepositoryRuleset
+	var resp *github.Response
+	if repo == "" {
+		rulesets, resp, err = client.Organizations.GetAllRepositoryRulesets(ctx, owner, &listOptions)
+	} else {
+		includesParents, paramErr := OptionalBoolParamWithDefault(args, "includes_parents", true)
+		if paramErr != nil {
+			return utils.NewToolResultError(paramErr.Error()), nil
+		}
+		rulesets, resp, err = client.Repositories.GetAllRulesets(ctx, owner, repo, &github.RepositoryListRulesetsOptions{
+			IncludesParents: github.Ptr(includesParents),
+			ListOptions:     listOptions,
+		})
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list rulesets", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if rulesets == nil {
+		rulesets = []*github.RepositoryRuleset{}
+	}
+	return MarshalledTextResult(rulesets), nil
+}
+
+func getRuleset(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	rulesetID, err := RequiredBigInt(args, "ruleset_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	ruleset, resp, err := fetchRuleset(ctx, client, owner, repo, rulesetID, args)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get ruleset", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(ruleset), nil
+}
+
+// fetchRuleset gets a repository ruleset, or an organization ruleset if repo is empty.
+func fetchRuleset(ctx context.Context, client *github.Client, owner, repo string, rulesetID int64, args map[string]any) (*github.RepositoryRuleset, *github.Response, error) {
+	if repo == "" {
+		return client.Organizations.GetRepositoryRuleset(ctx, owner, rulesetID)
+	}
+	includesParents, err := OptionalBoolParamWithDefault(args, "includes_parents", true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.Repositories.GetRuleset(ctx, owner, repo, rulesetID, includesParents)
+}
+
+// RulesetWrite creates a tool to create, update and delete repository and organization rulesets.
+func RulesetWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "ruleset_write",
+			Description: t("TOOL_RULESET_WRITE_DESCRIPTION", `Create, update or delete a ruleset of a repository, or of an organization if repo is omitted. Requires admin access to the repository or organization.
+Methods:
+- create: create a ruleset. ruleset must contain name and enforcement.
+- update: change a ruleset. Only the top-level fields present in ruleset are replaced; for example, passing rules replaces all rules.
+- delete: delete a ruleset.
+Use enforcement 'evaluate' to try out a ruleset without blocking anyone.`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_RULESET_WRITE_USER_TITLE", "Manage rulesets"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type:        "string",
+						Description: "Operation to perform",
+						Enum:        []any{"create", "update", "delete"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner, or the organization for organization rulesets",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name. Omit for organization rulesets.",
+					},
+					"ruleset_id": {
+						Type:        "number",
+						Description: "Ruleset ID. Required for 'update' and 'delete'.",
+					},
+					"ruleset": {
+						Type:        "object",
+						Description: "Ruleset in the format of the GitHub REST API. Required for 'create' and 'update'.",
+						Properties: map[string]*jsonschema.Schema{
+							"name": {
+								Type:        "string",
+								Description: "Name of the ruleset",
+							},
+							"target": {
+								Type:        "string",
+								Description: "Target of the ruleset",
+								Enum:        []any{"branch", "tag", "push"},
+							},
+							"enforcement": {
+								Type:        "string",
+								Description: "Enforcement level",
+								Enum:        []any{"disabled", "active", "evaluate"},
+							},
+							"bypass_actors": {
+								Type:        "array",
+								Description: "Actors that can bypass the ruleset, each with actor_id, actor_type (Integration, OrganizationAdmin, RepositoryRole, Team, DeployKey) and bypass_mode (always, pull_request)",
+								Items: &jsonschema.Schema{
+									Type: "object",
+								},
+							},
+							"conditions": {
+								Type:        "object",
+								Description: `Conditions selecting the refs and, for organization rulesets, the repositories the ruleset applies to, e.g. {"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}}`,
+							},
+							"rules": {
+								Type:        "array",
+								Description: `Rules, each with a type and optional parameters, e.g. {"type": "pull_request", "parameters": {"required_approving_review_count": 1, ...}} or {"type": "non_fast_forward"}`,
+								Items: &jsonschema.Schema{
+									Type: "object",
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"method", "owner"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo, scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := OptionalParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case "create":
+				result, err := createRuleset(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "update":
+				result, err := updateRuleset(ctx, client, owner, repo, args)
+				return result, nil, err
+			case "delete":
+				result, err := deleteRuleset(ctx, client, owner, repo, args)
+				return result, nil, err
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+// rulesetFields returns the ruleset argument as a map of top-level fields.
+func rulesetFields(args map[string]any) (map[string]any, error) {
+	fields, ok := args["ruleset"].(map[string]any)
+	if !ok || len(fields) == 0 {
+		return nil, fmt.Errorf("missing required parameter: ruleset")
+	}
+	return fields, nil
+}
+
+// decodeRuleset converts ruleset fields in the REST API format to a ruleset.
+func decodeRuleset(fields map[string]any) (github.RepositoryRuleset, error) {
+	var ruleset github.RepositoryRuleset
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return ruleset, err
+	}
+	if err := json.Unmarshal(data, &ruleset); err != nil {
+		return ruleset, fmt.Errorf("invalid ruleset: %w", err)
+	}
+	return ruleset, nil
+}
+
+func createRuleset(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	fields, err := rulesetFields(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	ruleset, err := decodeRuleset(fields)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	if ruleset.Name == "" || ruleset.Enforcement == "" {
+		return utils.NewToolResultError("ruleset must contain name and enforcement"), nil
+	}
+
+	var created *github.RepositoryRuleset
+	var resp *github.Response
+	if repo == "" {
+		created, resp, err = client.Organizations.CreateRepositoryRuleset(ctx, owner, ruleset)
+	} else {
+		created, resp, err = client.Repositories.CreateRuleset(ctx, owner, repo, ruleset)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create ruleset", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(MinimalResponse{
+		ID:  fmt.Sprintf("%d", created.GetID()),
+		URL: created.GetLinks().GetHTML().GetHRef(),
+	}), nil
+}
+
+func updateRuleset(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	rulesetID, err := RequiredBigInt(args, "ruleset_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	fields, err := rulesetFields(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	// The API replaces every field it is sent, so the given fields are applied on
+	// top of the current ruleset to leave the others unchanged.
+	current, resp, err := fetchRuleset(ctx, client, owner, repo, rulesetID, map[string]any{"includes_parents": false})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get ruleset", resp, err), nil
+	}
+	_ = resp.Body.Close()
+
+	data, err := json.Marshal(current)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ruleset: %w", err)
+	}
+	merged := map[string]any{}
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ruleset: %w", err)
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+	ruleset, err := decodeRuleset(merged)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	ruleset.ID, ruleset.SourceType, ruleset.Source, ruleset.NodeID = nil, nil, "", nil
+	ruleset.CurrentUserCanBypass, ruleset.Links, ruleset.CreatedAt, ruleset.UpdatedAt = nil, nil, nil, nil
+
+	var updated *github.RepositoryRuleset
+	if repo == "" {
+		updated, resp, err = client.Organizations.UpdateRepositoryRuleset(ctx, owner, rulesetID, ruleset)
+	} else {
+		updated, resp, err = client.Repositories.UpdateRuleset(ctx, owner, repo, rulesetID, ruleset)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update ruleset", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(MinimalResponse{
+		ID:  fmt.Sprintf("%d", updated.GetID()),
+		URL: updated.GetLinks().GetHTML().GetHRef(),
+	}), nil
+}
+
+func deleteRuleset(ctx context.Context, client *github.Client, owner, repo string, args map[string]any) (*mcp.CallToolResult, error) {
+	rulesetID, err := RequiredBigInt(args, "ruleset_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	var resp *github.Response
+	if repo == "" {
+		resp, err = client.Organizations.DeleteRepositoryRuleset(ctx, owner, rulesetID)
+	} else {
+		resp, err = client.Repositories.DeleteRuleset(ctx, owner, repo, rulesetID)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete ruleset", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return utils.NewToolResultText(fmt.Sprintf("ruleset %d deleted successfully", rulesetID)), nil
+}
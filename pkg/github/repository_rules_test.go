@@ -0,0 +1,275 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepositoryRulesRead(t *testing.T) {
+	serverTool := RepositoryRulesRead(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "repository_rules_read", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "branch")
+	assert.Contains(t, schema.Properties, "ruleset_id")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner"})
+	assert.ElementsMatch(t, []string{"repo"}, serverTool.RequiredScopes)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "rules for branch",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposRulesBranchesByOwnerByRepoByBranch: expectQueryParams(t, map[string]string{
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(mockResponse(t, http.StatusOK, `[
+					{"type": "pull_request", "ruleset_source_type": "Organization", "ruleset_source": "org", "ruleset_id": 7, "parameters": {"required_approving_review_count": 2}},
+					{"type": "non_fast_forward", "ruleset_source_type": "Repository", "ruleset_source": "owner/repo", "ruleset_id": 8}
+				]`)),
+			}),
+			requestArgs:  map[string]any{"method": "get_rules_for_branch", "owner": "owner", "repo": "repo", "branch": "main"},
+			expectedText: `"required_approving_review_count":2`,
+		},
+		{
+			name:           "rules for branch without repo",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "get_rules_for_branch", "owner": "owner", "branch": "main"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: repo",
+		},
+		{
+			name: "branch protection",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposBranchesProtectionByOwnerByRepoByBranch: mockResponse(t, http.StatusOK, &github.Protection{
+					RequiredStatusChecks: &github.RequiredStatusChecks{Strict: true, Contexts: &[]string{"ci/build"}},
+				}),
+			}),
+			requestArgs:  map[string]any{"method": "get_branch_protection", "owner": "owner", "repo": "repo", "branch": "main"},
+			expectedText: `"ci/build"`,
+		},
+		{
+			name: "branch not protected",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposBranchesProtectionByOwnerByRepoByBranch: mockResponse(t, http.StatusNotFound, `{"message": "Branch not protected"}`),
+			}),
+			requestArgs:  map[string]any{"method": "get_branch_protection", "owner": "owner", "repo": "repo", "branch": "dev"},
+			expectedText: "branch dev has no classic branch protection",
+		},
+		{
+			name: "organization rulesets",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsRulesetsByOrg: mockResponse(t, http.StatusOK, []*github.RepositoryRuleset{
+					{ID: github.Ptr(int64(7)), Name: "Org protection", Enforcement: github.RulesetEnforcementActive},
+				}),
+			}),
+			requestArgs:  map[string]any{"method": "list_rulesets", "owner": "org"},
+			expectedText: `"name":"Org protection"`,
+		},
+		{
+			name: "repository ruleset",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepoByRulesetID: expectQueryParams(t, map[string]string{
+					"includes_parents": "true",
+				}).andThen(mockResponse(t, http.StatusOK, `{"id": 8, "name": "main", "enforcement": "evaluate", "rules": [{"type": "deletion"}]}`)),
+			}),
+			requestArgs:  map[string]any{"method": "get_ruleset", "owner": "owner", "repo": "repo", "ruleset_id": float64(8)},
+			expectedText: `"rules":[{"type":"deletion"}]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_RulesetWrite(t *testing.T) {
+	serverTool := RulesetWrite(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "ruleset_write", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.Contains(t, schema.Properties, "ruleset")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner"})
+
+	assert.ElementsMatch(t, []string{"repo", "admin:org"}, serverTool.RequiredScopes)
+
+	// Repository admins need repo, organization admins need admin:org
+	for _, tokenScopes := range [][]string{{"read:org", "public_repo"}, {"write:org"}} {
+		filter := CreateToolScopeFilter(tokenScopes)
+		visible, err := filter(context.Background(), &serverTool)
+		require.NoError(t, err)
+		assert.False(t, visible, "tool should be hidden for scopes %v", tokenScopes)
+	}
+	for _, tokenScopes := range [][]string{{"repo"}, {"admin:org"}} {
+		filter := CreateToolScopeFilter(tokenScopes)
+		visible, err := filter(context.Background(), &serverTool)
+		require.NoError(t, err)
+		assert.True(t, visible, "tool should be visible for scopes %v", tokenScopes)
+	}
+
+	currentRuleset := `{
+		"id": 8,
+		"name": "main",
+		"target": "branch",
+		"source_type": "Repository",
+		"source": "owner/repo",
+		"enforcement": "evaluate",
+		"conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}},
+		"rules": [{"type": "deletion"}],
+		"_links": {"html": {"href": "https://github.com/owner/repo/rules/8"}}
+	}`
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "create repository ruleset",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposRulesetsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"name":        "main",
+					"target":      "branch",
+					"source":      "",
+					"enforcement": "active",
+					"conditions":  map[string]any{"ref_name": map[string]any{"include": []any{"~DEFAULT_BRANCH"}, "exclude": []any{}}},
+					"rules": []any{
+						map[string]any{"type": "required_linear_history"},
+						map[string]any{"type": "non_fast_forward"},
+					},
+				}).andThen(mockResponse(t, http.StatusCreated, currentRuleset)),
+			}),
+			requestArgs: map[string]any{
+				"method": "create",
+				"owner":  "owner",
+				"repo":   "repo",
+				"ruleset": map[string]any{
+					"name":        "main",
+					"target":      "branch",
+					"enforcement": "active",
+					"conditions":  map[string]any{"ref_name": map[string]any{"include": []any{"~DEFAULT_BRANCH"}, "exclude": []any{}}},
+					"rules": []any{
+						map[string]any{"type": "non_fast_forward"},
+						map[string]any{"type": "required_linear_history"},
+					},
+				},
+			},
+			expectedText: `"url":"https://github.com/owner/repo/rules/8"`,
+		},
+		{
+			name:         "create without enforcement",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":  "create",
+				"owner":   "owner",
+				"repo":    "repo",
+				"ruleset": map[string]any{"name": "main"},
+			},
+			expectError:    true,
+			expectedErrMsg: "ruleset must contain name and enforcement",
+		},
+		{
+			name: "update keeps fields that are not given",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepoByRulesetID: mockResponse(t, http.StatusOK, currentRuleset),
+				PutReposRulesetsByOwnerByRepoByRulesetID: expectRequestBody(t, map[string]any{
+					"name":        "main",
+					"target":      "branch",
+					"source":      "",
+					"enforcement": "active",
+					"conditions":  map[string]any{"ref_name": map[string]any{"include": []any{"~DEFAULT_BRANCH"}, "exclude": []any{}}},
+					"rules":       []any{map[string]any{"type": "deletion"}},
+				}).andThen(mockResponse(t, http.StatusOK, currentRuleset)),
+			}),
+			requestArgs: map[string]any{
+				"method":     "update",
+				"owner":      "owner",
+				"repo":       "repo",
+				"ruleset_id": float64(8),
+				"ruleset":    map[string]any{"enforcement": "active"},
+			},
+			expectedText: `"id":"8"`,
+		},
+		{
+			name: "delete organization ruleset",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteOrgsRulesetsByOrgByRulesetID: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}),
+			requestArgs:  map[string]any{"method": "delete", "owner": "org", "ruleset_id": float64(7)},
+			expectedText: "ruleset 7 deleted successfully",
+		},
+		{
+			name: "create fails without admin access",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposRulesetsByOwnerByRepo: mockResponse(t, http.StatusForbidden, `{"message": "Must have admin rights to Repository."}`),
+			}),
+			requestArgs: map[string]any{
+				"method":  "create",
+				"owner":   "owner",
+				"repo":    "repo",
+				"ruleset": map[string]any{"name": "main", "enforcement": "active"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create ruleset",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
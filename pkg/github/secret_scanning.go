@@ -187,3 +187,196 @@ func ListSecretScanningAlerts(t translations.TranslationHelperFunc) inventory.Se
 		},
 	)
 }
+
+// ListSecretScanningAlertLocations creates a tool to list where the secret of a secret scanning alert was found.
+func ListSecretScanningAlertLocations(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataSecretProtection,
+		mcp.Tool{
+			Name:        "list_secret_scanning_alert_locations",
+			Description: t("TOOL_LIST_SECRET_SCANNING_ALERT_LOCATIONS_DESCRIPTION", "List the locations where the secret of a secret scanning alert was found, such as commits, issues, pull requests and wiki pages."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_SECRET_SCANNING_ALERT_LOCATIONS_USER_TITLE", "List secret scanning alert locations"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"alertNumber": {
+						Type:        "number",
+						Description: "The number of the alert.",
+					},
+				},
+				Required: []string{"owner", "repo", "alertNumber"},
+			}),
+		},
+		[]scopes.Scope{scopes.SecurityEvents},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			alertNumber, err := RequiredInt(args, "alertNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			locations, resp, err := client.SecretScanning.ListLocationsForAlert(ctx, owner, repo, int64(alertNumber), &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list locations for alert with number '%d'", alertNumber),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(locations)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal locations: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// SecretScanningAlertWrite creates a tool to resolve and reopen secret scanning alerts.
+func SecretScanningAlertWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataSecretProtection,
+		mcp.Tool{
+			Name:        "secret_scanning_alert_write",
+			Description: t("TOOL_SECRET_SCANNING_ALERT_WRITE_DESCRIPTION", "Resolve or reopen a secret scanning alert in a GitHub repository."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_SECRET_SCANNING_ALERT_WRITE_USER_TITLE", "Resolve or reopen secret scanning alert"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The action to perform on the alert
+Options are:
+- 'resolve' - resolve an open alert, with a resolution and an optional comment.
+- 'reopen' - reopen a resolved alert.
+`,
+						Enum: []any{"resolve", "reopen"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"alertNumber": {
+						Type:        "number",
+						Description: "The number of the alert.",
+					},
+					"resolution": {
+						Type:        "string",
+						Description: "The reason for resolving the alert. Use 'revoked' once the secret has been rotated. Required for 'resolve'.",
+						Enum:        []any{"false_positive", "wont_fix", "revoked", "used_in_tests"},
+					},
+					"resolution_comment": {
+						Type:        "string",
+						Description: "A comment explaining the resolution, up to 270 characters. Only used for 'resolve'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo", "alertNumber"},
+			},
+		},
+		[]scopes.Scope{scopes.SecurityEvents},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			alertNumber, err := RequiredInt(args, "alertNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			var opts *github.SecretScanningAlertUpdateOptions
+			switch method {
+			case "resolve":
+				resolution, err := RequiredParam[string](args, "resolution")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				comment, err := OptionalParam[string](args, "resolution_comment")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				opts = &github.SecretScanningAlertUpdateOptions{
+					State:      "resolved",
+					Resolution: github.Ptr(resolution),
+				}
+				if comment != "" {
+					opts.ResolutionComment = github.Ptr(comment)
+				}
+			case "reopen":
+				opts = &github.SecretScanningAlertUpdateOptions{State: "open"}
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			alert, resp, err := client.SecretScanning.UpdateAlert(ctx, owner, repo, int64(alertNumber), opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update alert with number '%d'", alertNumber),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(alert)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal alert: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
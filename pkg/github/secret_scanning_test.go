@@ -249,3 +249,172 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListSecretScanningAlertLocations(t *testing.T) {
+	toolDef := ListSecretScanningAlertLocations(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "list_secret_scanning_alert_locations", toolDef.Tool.Name)
+	assert.True(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	schema, ok := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "page")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "alertNumber"})
+
+	mockLocations := []*github.SecretScanningAlertLocation{
+		{
+			Type: github.Ptr("commit"),
+			Details: &github.SecretScanningAlertLocationDetails{
+				Path:      github.Ptr("config/settings.yml"),
+				Startline: github.Ptr(12),
+				CommitSHA: github.Ptr("f14d7debf9775f957cf4f1e8176da0786431f72b"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]any
+		expectError       bool
+		expectedLocations []*github.SecretScanningAlertLocation
+		expectedErrMsg    string
+	}{
+		{
+			name: "successful locations listing",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposSecretScanningAlertsLocationsByOwnerByRepoByAlertNumber: expectQueryParams(t, map[string]string{
+					"page":     "2",
+					"per_page": "10",
+				}).andThen(mockResponse(t, http.StatusOK, mockLocations)),
+			}),
+			requestArgs:       map[string]any{"owner": "owner", "repo": "repo", "alertNumber": float64(3), "page": float64(2), "perPage": float64(10)},
+			expectedLocations: mockLocations,
+		},
+		{
+			name: "alert not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposSecretScanningAlertsLocationsByOwnerByRepoByAlertNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs:    map[string]any{"owner": "owner", "repo": "repo", "alertNumber": float64(3)},
+			expectError:    true,
+			expectedErrMsg: "failed to list locations for alert with number '3'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returnedLocations []*github.SecretScanningAlertLocation
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedLocations))
+			assert.Equal(t, tc.expectedLocations, returnedLocations)
+		})
+	}
+}
+
+func Test_SecretScanningAlertWrite(t *testing.T) {
+	toolDef := SecretScanningAlertWrite(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "secret_scanning_alert_write", toolDef.Tool.Name)
+	assert.False(t, toolDef.Tool.Annotations.ReadOnlyHint)
+	schema, ok := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "resolution")
+	assert.Contains(t, schema.Properties, "resolution_comment")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo", "alertNumber"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedState  string
+		expectedErrMsg string
+	}{
+		{
+			name: "resolve alert as revoked",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state":              "resolved",
+					"resolution":         "revoked",
+					"resolution_comment": "Token rotated in #123",
+				}).andThen(mockResponse(t, http.StatusOK, &github.SecretScanningAlert{
+					Number:     github.Ptr(3),
+					State:      github.Ptr("resolved"),
+					Resolution: github.Ptr("revoked"),
+				})),
+			}),
+			requestArgs: map[string]any{
+				"method":             "resolve",
+				"owner":              "owner",
+				"repo":               "repo",
+				"alertNumber":        float64(3),
+				"resolution":         "revoked",
+				"resolution_comment": "Token rotated in #123",
+			},
+			expectedState: "resolved",
+		},
+		{
+			name:           "resolve alert without resolution",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "resolve", "owner": "owner", "repo": "repo", "alertNumber": float64(3)},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: resolution",
+		},
+		{
+			name: "reopen alert",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state": "open",
+				}).andThen(mockResponse(t, http.StatusOK, &github.SecretScanningAlert{
+					Number: github.Ptr(3),
+					State:  github.Ptr("open"),
+				})),
+			}),
+			requestArgs:   map[string]any{"method": "reopen", "owner": "owner", "repo": "repo", "alertNumber": float64(3)},
+			expectedState: "open",
+		},
+		{
+			name:           "unknown method",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "dismiss", "owner": "owner", "repo": "repo", "alertNumber": float64(3)},
+			expectError:    true,
+			expectedErrMsg: "unknown method: dismiss",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returnedAlert github.SecretScanningAlert
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedAlert))
+			assert.Equal(t, 3, returnedAlert.GetNumber())
+			assert.Equal(t, tc.expectedState, returnedAlert.GetState())
+		})
+	}
+}
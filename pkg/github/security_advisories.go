@@ -463,3 +463,428 @@ func ListOrgRepositorySecurityAdvisories(t translations.TranslationHelperFunc) i
 		},
 	)
 }
+
+// repositoryAdvisoryRequest is the body of the create and update repository
+// security advisory endpoints, which are not covered by go-github.
+type repositoryAdvisoryRequest struct {
+	Summary          string                          `json:"summary,omitempty"`
+	Description      string                          `json:"description,omitempty"`
+	CVEID            *string                         `json:"cve_id,omitempty"`
+	Severity         *string                         `json:"severity,omitempty"`
+	CVSSVectorString *string                         `json:"cvss_vector_string,omitempty"`
+	Vulnerabilities  []*github.AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	CWEIDs           []string                        `json:"cwe_ids,omitempty"`
+	Credits          []*github.RepoAdvisoryCredit    `json:"credits,omitempty"`
+	State            *string                         `json:"state,omitempty"`
+	StartPrivateFork *bool                           `json:"start_private_fork,omitempty"`
+}
+
+func RepositorySecurityAdvisoryWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataSecurityAdvisories,
+		mcp.Tool{
+			Name:        "repository_security_advisory_write",
+			Description: t("TOOL_REPOSITORY_SECURITY_ADVISORY_WRITE_DESCRIPTION", "Create or update a draft repository security advisory, request a CVE for it, or create a temporary private fork to collaborate on a fix. Requires admin or security manager access to the repository."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_REPOSITORY_SECURITY_ADVISORY_WRITE_USER_TITLE", "Manage repository security advisory"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {
+						Type: "string",
+						Description: `The action to perform
+Options are:
+- 'create' - create a draft advisory. Requires summary, description and vulnerabilities.
+- 'update' - update the advisory given by ghsa_id. Only the provided fields are changed.
+- 'request_cve' - request a CVE ID for the advisory given by ghsa_id.
+- 'create_private_fork' - create a temporary private fork to fix the vulnerability of the advisory given by ghsa_id.
+`,
+						Enum: []any{"create", "update", "request_cve", "create_private_fork"},
+					},
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"ghsa_id": {
+						Type:        "string",
+						Description: "GitHub Security Advisory ID (format: GHSA-xxxx-xxxx-xxxx). Required for all methods except 'create'.",
+					},
+					"summary": {
+						Type:        "string",
+						Description: "A short summary of the advisory.",
+					},
+					"description": {
+						Type:        "string",
+						Description: "A detailed description of the vulnerability, in Markdown.",
+					},
+					"severity": {
+						Type:        "string",
+						Description: "Severity of the advisory. Cannot be set together with cvss_vector_string.",
+						Enum:        []any{"critical", "high", "medium", "low"},
+					},
+					"cvss_vector_string": {
+						Type:        "string",
+						Description: "CVSS vector to calculate the severity from. Cannot be set together with severity.",
+					},
+					"cve_id": {
+						Type:        "string",
+						Description: "An existing CVE ID for the advisory.",
+					},
+					"vulnerabilities": {
+						Type:        "array",
+						Description: "Affected packages. Replaces the existing list on update.",
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"ecosystem": {
+									Type:        "string",
+									Description: "Package ecosystem.",
+									Enum:        []any{"actions", "composer", "erlang", "go", "maven", "npm", "nuget", "other", "pip", "pub", "rubygems", "rust", "swift"},
+								},
+								"package": {
+									Type:        "string",
+									Description: "Package name.",
+								},
+								"vulnerable_version_range": {
+									Type:        "string",
+									Description: "Range of affected versions (e.g. \"< 1.2.3\").",
+								},
+								"patched_versions": {
+									Type:        "string",
+									Description: "Versions that fix the vulnerability (e.g. \"1.2.3\").",
+								},
+								"vulnerable_functions": {
+									Type:        "array",
+									Description: "Affected functions.",
+									Items: &jsonschema.Schema{
+										Type: "string",
+									},
+								},
+							},
+							Required: []string{"ecosystem", "package"},
+						},
+					},
+					"cwe_ids": {
+						Type:        "array",
+						Description: "Common Weakness Enumeration IDs (e.g. [\"CWE-79\", \"CWE-284\"]). Replaces the existing list on update.",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"credits": {
+						Type:        "array",
+						Description: "Users to credit for the advisory. Replaces the existing list on update.",
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"login": {
+									Type:        "string",
+									Description: "Username of the credited user.",
+								},
+								"type": {
+									Type:        "string",
+									Description: "Type of the credit.",
+									Enum:        []any{"analyst", "finder", "reporter", "coordinator", "remediation_developer", "remediation_reviewer", "remediation_verifier", "tool", "sponsor", "other"},
+								},
+							},
+							Required: []string{"login", "type"},
+						},
+					},
+					"state": {
+						Type:        "string",
+						Description: "New state of the advisory. Only used by 'update'.",
+						Enum:        []any{"draft", "closed"},
+					},
+					"start_private_fork": {
+						Type:        "boolean",
+						Description: "Whether to create a temporary private fork together with the advisory. Only used by 'create'.",
+					},
+				},
+				Required: []string{"method", "owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			var ghsaID string
+			if method != "create" {
+				ghsaID, err = RequiredParam[string](args, "ghsa_id")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			switch method {
+			case "create":
+				return createRepositoryAdvisory(ctx, client, args, owner, repo)
+			case "update":
+				return updateRepositoryAdvisory(ctx, client, args, owner, repo, ghsaID)
+			case "request_cve":
+				resp, err := client.SecurityAdvisories.RequestCVE(ctx, owner, repo, ghsaID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to request CVE", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				return utils.NewToolResultText(fmt.Sprintf("CVE requested for advisory %s", ghsaID)), nil, nil
+			case "create_private_fork":
+				fork, resp, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, owner, repo, ghsaID)
+				if err != nil && !isAcceptedError(err) {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create temporary private fork", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				// The fork is created asynchronously, but its name and URL are already known.
+				r, err := json.Marshal(MinimalResponse{
+					ID:  fork.GetFullName(),
+					URL: fork.GetHTMLURL(),
+				})
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+				return utils.NewToolResultText(string(r)), nil, nil
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+		},
+	)
+}
+
+func createRepositoryAdvisory(ctx context.Context, client *github.Client, args map[string]any, owner, repo string) (*mcp.CallToolResult, any, error) {
+	summary, err := RequiredParam[string](args, "summary")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	description, err := RequiredParam[string](args, "description")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	body, err := repositoryAdvisoryRequestFromArgs(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if len(body.Vulnerabilities) == 0 {
+		return utils.NewToolResultError("vulnerabilities must contain at least one affected package"), nil, nil
+	}
+	startPrivateFork, err := OptionalParam[bool](args, "start_private_fork")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	body.Summary = summary
+	body.Description = description
+	if startPrivateFork {
+		body.StartPrivateFork = github.Ptr(true)
+	}
+
+	return sendRepositoryAdvisoryRequest(ctx, client, http.MethodPost, fmt.Sprintf("repos/%s/%s/security-advisories", owner, repo), body, "failed to create repository security advisory")
+}
+
+func updateRepositoryAdvisory(ctx context.Context, client *github.Client, args map[string]any, owner, repo, ghsaID string) (*mcp.CallToolResult, any, error) {
+	summary, err := OptionalParam[string](args, "summary")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	description, err := OptionalParam[string](args, "description")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	state, err := OptionalParam[string](args, "state")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	body, err := repositoryAdvisoryRequestFromArgs(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	body.Summary = summary
+	body.Description = description
+	if state != "" {
+		body.State = &state
+	}
+	if body.Summary == "" && body.Description == "" && body.State == nil && body.Severity == nil && body.CVSSVectorString == nil &&
+		body.CVEID == nil && len(body.CWEIDs) == 0 && len(body.Vulnerabilities) == 0 && len(body.Credits) == 0 {
+		return utils.NewToolResultError("at least one field of the advisory must be provided for update"), nil, nil
+	}
+
+	return sendRepositoryAdvisoryRequest(ctx, client, http.MethodPatch, fmt.Sprintf("repos/%s/%s/security-advisories/%s", owner, repo, ghsaID), body, "failed to update repository security advisory")
+}
+
+func sendRepositoryAdvisoryRequest(ctx context.Context, client *github.Client, method, path string, body *repositoryAdvisoryRequest, errMsg string) (*mcp.CallToolResult, any, error) {
+	req, err := client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	advisory := new(github.SecurityAdvisory)
+	resp, err := client.Do(ctx, req, advisory)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, errMsg, resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	r, err := json.Marshal(advisory)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal advisory: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// repositoryAdvisoryRequestFromArgs reads the advisory fields shared by create and update.
+func repositoryAdvisoryRequestFromArgs(args map[string]any) (*repositoryAdvisoryRequest, error) {
+	body := &repositoryAdvisoryRequest{}
+
+	severity, err := OptionalParam[string](args, "severity")
+	if err != nil {
+		return nil, err
+	}
+	cvssVector, err := OptionalParam[string](args, "cvss_vector_string")
+	if err != nil {
+		return nil, err
+	}
+	if severity != "" && cvssVector != "" {
+		return nil, fmt.Errorf("only one of severity or cvss_vector_string can be set")
+	}
+	if severity != "" {
+		body.Severity = &severity
+	}
+	if cvssVector != "" {
+		body.CVSSVectorString = &cvssVector
+	}
+
+	cveID, err := OptionalParam[string](args, "cve_id")
+	if err != nil {
+		return nil, err
+	}
+	if cveID != "" {
+		body.CVEID = &cveID
+	}
+
+	body.CWEIDs, err = OptionalStringArrayParam(args, "cwe_ids")
+	if err != nil {
+		return nil, err
+	}
+	body.Vulnerabilities, err = advisoryVulnerabilitiesFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	body.Credits, err = advisoryCreditsFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func advisoryVulnerabilitiesFromArgs(args map[string]any) ([]*github.AdvisoryVulnerability, error) {
+	raw, ok := args["vulnerabilities"]
+	if !ok {
+		return nil, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("vulnerabilities must be an array of affected packages")
+	}
+
+	vulnerabilities := make([]*github.AdvisoryVulnerability, 0, len(items))
+	for i, item := range items {
+		vulnArgs, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("vulnerability %d must be an object", i)
+		}
+		ecosystem, err := RequiredParam[string](vulnArgs, "ecosystem")
+		if err != nil {
+			return nil, fmt.Errorf("vulnerability %d: %w", i, err)
+		}
+		name, err := RequiredParam[string](vulnArgs, "package")
+		if err != nil {
+			return nil, fmt.Errorf("vulnerability %d: %w", i, err)
+		}
+		versionRange, err := OptionalParam[string](vulnArgs, "vulnerable_version_range")
+		if err != nil {
+			return nil, fmt.Errorf("vulnerability %s: %w", name, err)
+		}
+		patchedVersions, err := OptionalParam[string](vulnArgs, "patched_versions")
+		if err != nil {
+			return nil, fmt.Errorf("vulnerability %s: %w", name, err)
+		}
+		functions, err := OptionalStringArrayParam(vulnArgs, "vulnerable_functions")
+		if err != nil {
+			return nil, fmt.Errorf("vulnerability %s: %w", name, err)
+		}
+
+		vulnerability := &github.AdvisoryVulnerability{
+			Package: &github.VulnerabilityPackage{
+				Ecosystem: github.Ptr(ecosystem),
+				Name:      github.Ptr(name),
+			},
+			VulnerableFunctions: functions,
+		}
+		if versionRange != "" {
+			vulnerability.VulnerableVersionRange = github.Ptr(versionRange)
+		}
+		if patchedVersions != "" {
+			vulnerability.PatchedVersions = github.Ptr(patchedVersions)
+		}
+		vulnerabilities = append(vulnerabilities, vulnerability)
+	}
+
+	return vulnerabilities, nil
+}
+
+func advisoryCreditsFromArgs(args map[string]any) ([]*github.RepoAdvisoryCredit, error) {
+	raw, ok := args["credits"]
+	if !ok {
+		return nil, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("credits must be an array of credited users")
+	}
+
+	credits := make([]*github.RepoAdvisoryCredit, 0, len(items))
+	for i, item := range items {
+		creditArgs, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("credit %d must be an object", i)
+		}
+		login, err := RequiredParam[string](creditArgs, "login")
+		if err != nil {
+			return nil, fmt.Errorf("credit %d: %w", i, err)
+		}
+		typ, err := RequiredParam[string](creditArgs, "type")
+		if err != nil {
+			return nil, fmt.Errorf("credit %s: %w", login, err)
+		}
+		credits = append(credits, &github.RepoAdvisoryCredit{
+			Login: github.Ptr(login),
+			Type:  github.Ptr(typ),
+		})
+	}
+
+	return credits, nil
+}
@@ -499,3 +499,198 @@ func Test_ListOrgRepositorySecurityAdvisories(t *testing.T) {
 		})
 	}
 }
+
+func Test_RepositorySecurityAdvisoryWrite(t *testing.T) {
+	serverTool := RepositorySecurityAdvisoryWrite(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "repository_security_advisory_write", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "vulnerabilities")
+	assert.Contains(t, schema.Properties, "credits")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo"})
+
+	mockAdvisory := &github.SecurityAdvisory{
+		GHSAID:  github.Ptr("GHSA-abcd-1234-efgh"),
+		Summary: github.Ptr("Path traversal in archive extraction"),
+		State:   github.Ptr("draft"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/security/advisories/GHSA-abcd-1234-efgh"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "create draft advisory",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposSecurityAdvisoriesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"summary":     "Path traversal in archive extraction",
+					"description": "Extracting a crafted archive writes outside the target directory.",
+					"severity":    "high",
+					"cwe_ids":     []any{"CWE-22"},
+					"vulnerabilities": []any{
+						map[string]any{
+							"package":                  map[string]any{"ecosystem": "go", "name": "github.com/owner/repo"},
+							"vulnerable_version_range": "< 1.2.3",
+							"patched_versions":         "1.2.3",
+						},
+					},
+					"credits": []any{
+						map[string]any{"login": "reporter", "type": "finder"},
+					},
+					"start_private_fork": true,
+				}).andThen(mockResponse(t, http.StatusCreated, mockAdvisory)),
+			}),
+			requestArgs: map[string]any{
+				"method":      "create",
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Path traversal in archive extraction",
+				"description": "Extracting a crafted archive writes outside the target directory.",
+				"severity":    "high",
+				"cwe_ids":     []any{"CWE-22"},
+				"vulnerabilities": []any{
+					map[string]any{
+						"ecosystem":                "go",
+						"package":                  "github.com/owner/repo",
+						"vulnerable_version_range": "< 1.2.3",
+						"patched_versions":         "1.2.3",
+					},
+				},
+				"credits": []any{
+					map[string]any{"login": "reporter", "type": "finder"},
+				},
+				"start_private_fork": true,
+			},
+			expectedText: `"ghsa_id":"GHSA-abcd-1234-efgh"`,
+		},
+		{
+			name:         "create without vulnerabilities",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":      "create",
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Summary",
+				"description": "Description",
+			},
+			expectError:    true,
+			expectedErrMsg: "vulnerabilities must contain at least one affected package",
+		},
+		{
+			name:         "create with vulnerability missing package",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":          "create",
+				"owner":           "owner",
+				"repo":            "repo",
+				"summary":         "Summary",
+				"description":     "Description",
+				"vulnerabilities": []any{map[string]any{"ecosystem": "npm"}},
+			},
+			expectError:    true,
+			expectedErrMsg: "vulnerability 0: missing required parameter: package",
+		},
+		{
+			name:         "severity and cvss vector are exclusive",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":             "update",
+				"owner":              "owner",
+				"repo":               "repo",
+				"ghsa_id":            "GHSA-abcd-1234-efgh",
+				"severity":           "low",
+				"cvss_vector_string": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+			},
+			expectError:    true,
+			expectedErrMsg: "only one of severity or cvss_vector_string can be set",
+		},
+		{
+			name: "update advisory",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposSecurityAdvisoriesByOwnerByRepoByGhsaID: expectRequestBody(t, map[string]any{
+					"cvss_vector_string": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+					"state":              "closed",
+				}).andThen(mockResponse(t, http.StatusOK, mockAdvisory)),
+			}),
+			requestArgs: map[string]any{
+				"method":             "update",
+				"owner":              "owner",
+				"repo":               "repo",
+				"ghsa_id":            "GHSA-abcd-1234-efgh",
+				"cvss_vector_string": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+				"state":              "closed",
+			},
+			expectedText: `"ghsa_id":"GHSA-abcd-1234-efgh"`,
+		},
+		{
+			name:           "update without fields",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "update", "owner": "owner", "repo": "repo", "ghsa_id": "GHSA-abcd-1234-efgh"},
+			expectError:    true,
+			expectedErrMsg: "at least one field of the advisory must be provided for update",
+		},
+		{
+			name:           "update without ghsa_id",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"method": "update", "owner": "owner", "repo": "repo", "summary": "New summary"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: ghsa_id",
+		},
+		{
+			name: "request CVE",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposSecurityAdvisoriesCveByOwnerByRepoByGhsaID: mockResponse(t, http.StatusAccepted, map[string]any{}),
+			}),
+			requestArgs:  map[string]any{"method": "request_cve", "owner": "owner", "repo": "repo", "ghsa_id": "GHSA-abcd-1234-efgh"},
+			expectedText: "CVE requested for advisory GHSA-abcd-1234-efgh",
+		},
+		{
+			name: "request CVE without admin access",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposSecurityAdvisoriesCveByOwnerByRepoByGhsaID: mockResponse(t, http.StatusForbidden, map[string]string{"message": "Forbidden"}),
+			}),
+			requestArgs:    map[string]any{"method": "request_cve", "owner": "owner", "repo": "repo", "ghsa_id": "GHSA-abcd-1234-efgh"},
+			expectError:    true,
+			expectedErrMsg: "failed to request CVE",
+		},
+		{
+			name: "create temporary private fork",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposSecurityAdvisoriesForksByOwnerByRepoByGhsaID: mockResponse(t, http.StatusAccepted, &github.Repository{
+					FullName: github.Ptr("owner/repo-ghsa-abcd-1234-efgh"),
+					HTMLURL:  github.Ptr("https://github.com/owner/repo-ghsa-abcd-1234-efgh"),
+				}),
+			}),
+			requestArgs:  map[string]any{"method": "create_private_fork", "owner": "owner", "repo": "repo", "ghsa_id": "GHSA-abcd-1234-efgh"},
+			expectedText: `{"id":"owner/repo-ghsa-abcd-1234-efgh","url":"https://github.com/owner/repo-ghsa-abcd-1234-efgh"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: github.NewClient(tc.mockedClient),
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
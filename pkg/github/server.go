@@ -8,9 +8,12 @@ import (
 	"strings"
 	"time"
 
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	gherrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/http/transport"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/octicons"
+	"github.com/github/github-mcp-server/pkg/telemetry"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -26,6 +29,18 @@ type MCPServerConfig struct {
 	// GitHub Token to authenticate with the GitHub API
 	Token string
 
+	// TokenSource, when set, supplies tokens for each GitHub API request and takes
+	// precedence over Token. This is used for GitHub App installation tokens,
+	// which expire and must be refreshed while the server is running.
+	TokenSource transport.TokenSource
+
+	// ResponseCache, when set, caches REST GET responses and revalidates them with
+	// conditional requests, which do not count against the rate limit when unchanged.
+	ResponseCache transport.CacheStore
+
+	// Telemetry, when set, traces MCP requests and GitHub API calls and records their metrics
+	Telemetry *telemetry.Telemetry
+
 	// EnabledToolsets is a list of toolsets to enable
 	// See: https://github.com/github/github-mcp-server?tab=readme-ov-file#tool-configuration
 	EnabledToolsets []string
@@ -45,6 +60,18 @@ type MCPServerConfig struct {
 	// ReadOnly indicates if we should only offer read-only tools
 	ReadOnly bool
 
+	// DryRun makes tools without ReadOnlyHint describe their write requests instead of sending them
+	DryRun bool
+
+	// ToolsetOverrides customizes the tools exposed by individual toolsets, keyed by toolset ID
+	ToolsetOverrides map[string]ToolsetOverride
+
+	// RepoPolicy, when set, restricts which owners and repositories tool calls may target
+	RepoPolicy *RepoPolicy
+
+	// Confirmation, when set, selects tool calls the user must confirm through elicitation
+	Confirmation *ConfirmationPolicy
+
 	// Translator provides translated text for the server tooling
 	Translator translations.TranslationHelperFunc
 
@@ -87,10 +114,11 @@ func NewMCPServer(ctx context.Context, cfg *MCPServerConfig, deps ToolDependenci
 	}
 
 	// In dynamic mode, explicitly advertise capabilities since tools/resources/prompts
-	// may be enabled at runtime even if none are registered initially.
+	// may be enabled at runtime even if none are registered initially. Enabling a
+	// toolset changes the tool list, which clients are notified of.
 	if cfg.DynamicToolsets {
 		serverOpts.Capabilities = &mcp.ServerCapabilities{
-			Tools:     &mcp.ToolCapabilities{},
+			Tools:     &mcp.ToolCapabilities{ListChanged: true},
 			Resources: &mcp.ResourceCapabilities{},
 			Prompts:   &mcp.PromptCapabilities{},
 		}
@@ -100,8 +128,27 @@ func NewMCPServer(ctx context.Context, cfg *MCPServerConfig, deps ToolDependenci
 
 	// Add middlewares
 	ghServer.AddReceivingMiddleware(addGitHubAPIErrorToContext)
+	ghServer.AddReceivingMiddleware(addRateLimitMeta)
 	ghServer.AddReceivingMiddleware(InjectDepsMiddleware(deps))
 
+	if cfg.Confirmation != nil && cfg.Confirmation.Enabled() {
+		ghServer.AddReceivingMiddleware(ConfirmationMiddleware(*cfg.Confirmation, inv))
+	}
+
+	// Dry-run wraps confirmation so calls that make no changes are not confirmed
+	ghServer.AddReceivingMiddleware(DryRunMiddleware(inv, cfg.DryRun))
+
+	if cfg.RepoPolicy != nil {
+		repoPolicy, err := RepoPolicyMiddleware(*cfg.RepoPolicy, inv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid repository policy: %w", err)
+		}
+		ghServer.AddReceivingMiddleware(repoPolicy)
+	}
+
+	// Telemetry is added last so its span covers every other middleware
+	ghServer.AddReceivingMiddleware(cfg.Telemetry.Middleware())
+
 	if unrecognized := inv.UnrecognizedToolsets(); len(unrecognized) > 0 {
 		cfg.Logger.Warn("Warning: unrecognized toolsets ignored", "toolsets", strings.Join(unrecognized, ", "))
 	}
@@ -169,6 +216,34 @@ func addGitHubAPIErrorToContext(next mcp.MethodHandler) mcp.MethodHandler {
 	}
 }
 
+// RateLimitMetaKey is the tool result _meta key under which the GitHub API rate limit
+// status observed while handling the call is reported.
+const RateLimitMetaKey = "github.com/rate_limits"
+
+// addRateLimitMeta records the rate limit headers of GitHub API responses made during
+// a tool call and reports the remaining quota per resource in the result's _meta, so
+// clients and agents can slow down before they hit a limit.
+func addRateLimitMeta(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if method != inventory.MCPMethodToolsCall {
+			return next(ctx, method, req)
+		}
+
+		ctx, recorder := ghcontext.WithRateLimitRecorder(ctx)
+		result, err := next(ctx, method, req)
+
+		if callResult, ok := result.(*mcp.CallToolResult); ok && callResult != nil {
+			if limits := recorder.RateLimits(); len(limits) > 0 {
+				if callResult.Meta == nil {
+					callResult.Meta = mcp.Meta{}
+				}
+				callResult.Meta[RateLimitMetaKey] = limits
+			}
+		}
+		return result, err
+	}
+}
+
 // NewServer creates a new GitHub MCP server with the specified GH client and logger.
 func NewServer(version string, opts *mcp.ServerOptions) *mcp.Server {
 	if opts == nil {
@@ -9,10 +9,12 @@ import (
 	"testing"
 	"time"
 
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	"github.com/github/github-mcp-server/pkg/lockdown"
 	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/github/github-mcp-server/pkg/translations"
 	gogithub "github.com/google/go-github/v82/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -219,3 +221,32 @@ func TestResolveEnabledToolsets(t *testing.T) {
 		})
 	}
 }
+
+func TestAddRateLimitMeta(t *testing.T) {
+	reset := time.Unix(1700000060, 0).UTC()
+
+	handler := addRateLimitMeta(func(ctx context.Context, _ string, _ mcp.Request) (mcp.Result, error) {
+		ghcontext.RecordRateLimit(ctx, ghcontext.RateLimit{Resource: "search", Limit: 30, Remaining: 1, Used: 29, Reset: reset})
+		ghcontext.RecordRateLimit(ctx, ghcontext.RateLimit{Resource: "core", Limit: 5000, Remaining: 4999, Used: 1, Reset: reset})
+		return &mcp.CallToolResult{}, nil
+	})
+
+	result, err := handler(context.Background(), "tools/call", &mcp.CallToolRequest{})
+	require.NoError(t, err)
+
+	callResult, ok := result.(*mcp.CallToolResult)
+	require.True(t, ok)
+	assert.Equal(t, []ghcontext.RateLimit{
+		{Resource: "core", Limit: 5000, Remaining: 4999, Used: 1, Reset: reset},
+		{Resource: "search", Limit: 30, Remaining: 1, Used: 29, Reset: reset},
+	}, callResult.Meta[RateLimitMetaKey])
+
+	t.Run("no GitHub requests", func(t *testing.T) {
+		handler := addRateLimitMeta(func(_ context.Context, _ string, _ mcp.Request) (mcp.Result, error) {
+			return &mcp.CallToolResult{}, nil
+		})
+		result, err := handler(context.Background(), "tools/call", &mcp.CallToolRequest{})
+		require.NoError(t, err)
+		assert.Nil(t, result.(*mcp.CallToolResult).Meta)
+	})
+}
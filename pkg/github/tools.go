@@ -128,6 +128,11 @@ var (
 		Description: "Discover GitHub MCP tools that can help achieve tasks by enabling additional sets of tools, you can control the enablement of any toolset to access its tools when this toolset is enabled.",
 		Icon:        "tools",
 	}
+	ToolsetMetadataDeployments = inventory.ToolsetMetadata{
+		ID:          "deployments",
+		Description: "GitHub Deployments related tools",
+		Icon:        "git-commit",
+	}
 	ToolsetLabels = inventory.ToolsetMetadata{
 		ID:          "labels",
 		Description: "GitHub Labels related tools",
@@ -166,6 +171,7 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		SearchRepositories(t),
 		GetFileContents(t),
 		ListCommits(t),
+		CompareRefs(t),
 		SearchCode(t),
 		GetCommit(t),
 		ListBranches(t),
@@ -174,6 +180,10 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		ListReleases(t),
 		GetLatestRelease(t),
 		GetReleaseByTag(t),
+		ReleaseWrite(t),
+		DownloadReleaseAsset(t),
+		RepositoryRulesRead(t),
+		RulesetWrite(t),
 		CreateOrUpdateFile(t),
 		CreateRepository(t),
 		ForkRepository(t),
@@ -186,6 +196,9 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 
 		// Git tools
 		GetRepositoryTree(t),
+		GetFileBlame(t),
+		GitRead(t),
+		GitWrite(t),
 
 		// Issue tools
 		IssueRead(t),
@@ -194,8 +207,12 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		ListIssueTypes(t),
 		IssueWrite(t),
 		AddIssueComment(t),
+		IssueCommentWrite(t),
+		ReactionWrite(t),
 		AssignCopilotToIssue(t),
 		SubIssueWrite(t),
+		MilestoneRead(t),
+		MilestoneWrite(t),
 
 		// User tools
 		SearchUsers(t),
@@ -215,18 +232,26 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		PullRequestReviewWrite(t),
 		AddCommentToPendingReview(t),
 		AddReplyToPullRequestComment(t),
+		PullRequestReviewCommentWrite(t),
 
 		// Code security tools
 		GetCodeScanningAlert(t),
 		ListCodeScanningAlerts(t),
+		CodeScanningRead(t),
+		CodeScanningAlertWrite(t),
 
 		// Secret protection tools
 		GetSecretScanningAlert(t),
 		ListSecretScanningAlerts(t),
+		ListSecretScanningAlertLocations(t),
+		SecretScanningAlertWrite(t),
 
 		// Dependabot tools
 		GetDependabotAlert(t),
 		ListDependabotAlerts(t),
+		DependabotAlertWrite(t),
+		DependencyGraphRead(t),
+		CreateDependencySnapshot(t),
 
 		// Notification tools
 		ListNotifications(t),
@@ -240,6 +265,8 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		ListDiscussions(t),
 		GetDiscussion(t),
 		GetDiscussionComments(t),
+		DiscussionCommentWrite(t),
+		DiscussionWrite(t),
 		ListDiscussionCategories(t),
 
 		// Actions tools
@@ -247,12 +274,23 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		ActionsGet(t),
 		ActionsRunTrigger(t),
 		ActionsGetJobLogs(t),
+		ChecksRead(t),
+		ChecksRerequest(t),
+		ActionsConfigRead(t),
+		ActionsVariableWrite(t),
+		ActionsSecretWrite(t),
+		ReviewPendingDeployments(t),
+
+		// Deployment tools
+		DeploymentsRead(t),
+		DeploymentWrite(t),
 
 		// Security advisories tools
 		ListGlobalSecurityAdvisories(t),
 		GetGlobalSecurityAdvisory(t),
 		ListRepositorySecurityAdvisories(t),
 		ListOrgRepositorySecurityAdvisories(t),
+		RepositorySecurityAdvisoryWrite(t),
 
 		// Gist tools
 		ListGists(t),
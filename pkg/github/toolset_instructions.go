@@ -25,13 +25,21 @@ PR review workflow: Always use 'pull_request_review_write' with method 'create'
 
 Before creating a pull request, search for pull request templates in the repository. Template files are called pull_request_template.md or they're located in '.github/PULL_REQUEST_TEMPLATE' directory. Use the template content to structure the PR description and then call create_pull_request tool.`
 	}
+
+	if inv.HasToolset("dependabot") {
+		instructions += `
+
+When reviewing a pull request that changes dependency manifests or lock files, use 'dependency_graph_read' with method 'compare' on the base and head commits of the pull request to flag new dependencies with known vulnerabilities or changed licenses.`
+	}
 	return instructions
 }
 
 func generateDiscussionsToolsetInstructions(_ *inventory.Inventory) string {
 	return `## Discussions
 
-Use 'list_discussion_categories' to understand available categories before creating discussions. Filter by category for better organization.`
+Use 'list_discussion_categories' to understand available categories before creating discussions. Filter by category for better organization.
+
+In Q&A categories, use 'discussion_comment_write' with method 'mark_answer' on the comment that answers the question.`
 }
 
 func generateProjectsToolsetInstructions(_ *inventory.Inventory) string {
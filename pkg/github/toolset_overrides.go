@@ -0,0 +1,33 @@
+package github
+
+import (
+	"context"
+	"slices"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+)
+
+// ToolsetOverride customizes which tools of a single toolset are exposed.
+type ToolsetOverride struct {
+	// ReadOnly restricts the toolset to its read-only tools, even when the
+	// server itself is not in read-only mode.
+	ReadOnly bool `mapstructure:"read_only" json:"read_only,omitempty"`
+
+	// DisabledTools lists tools of the toolset that should never be exposed.
+	DisabledTools []string `mapstructure:"disabled_tools" json:"disabled_tools,omitempty"`
+}
+
+// CreateToolsetOverrideFilter creates an inventory.ToolFilter that applies
+// per-toolset overrides. Tools in toolsets without an override are unaffected.
+func CreateToolsetOverrideFilter(overrides map[string]ToolsetOverride) inventory.ToolFilter {
+	return func(_ context.Context, tool *inventory.ServerTool) (bool, error) {
+		override, ok := overrides[string(tool.Toolset.ID)]
+		if !ok {
+			return true, nil
+		}
+		if override.ReadOnly && !tool.IsReadOnly() {
+			return false, nil
+		}
+		return !slices.Contains(override.DisabledTools, tool.Tool.Name), nil
+	}
+}
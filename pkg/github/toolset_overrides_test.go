@@ -0,0 +1,62 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateToolsetOverrideFilter(t *testing.T) {
+	issueRead := &inventory.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "issue_read",
+			Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+		},
+		Toolset: ToolsetMetadataIssues,
+	}
+	issueWrite := &inventory.ServerTool{
+		Tool:    mcp.Tool{Name: "issue_write"},
+		Toolset: ToolsetMetadataIssues,
+	}
+	listIssues := &inventory.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "list_issues",
+			Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+		},
+		Toolset: ToolsetMetadataIssues,
+	}
+	createBranch := &inventory.ServerTool{
+		Tool:    mcp.Tool{Name: "create_branch"},
+		Toolset: ToolsetMetadataRepos,
+	}
+
+	filter := CreateToolsetOverrideFilter(map[string]ToolsetOverride{
+		"issues": {
+			ReadOnly:      true,
+			DisabledTools: []string{"list_issues"},
+		},
+	})
+
+	tests := []struct {
+		name     string
+		tool     *inventory.ServerTool
+		expected bool
+	}{
+		{name: "read-only tool in overridden toolset", tool: issueRead, expected: true},
+		{name: "write tool in read-only toolset", tool: issueWrite, expected: false},
+		{name: "disabled tool", tool: listIssues, expected: false},
+		{name: "toolset without override", tool: createBranch, expected: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, err := filter(context.Background(), tc.tool)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, allowed)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Package githubapp provides authentication for running the server as a GitHub App.
+//
+// An InstallationTokenSource signs short-lived JWTs with the App's private key,
+// exchanges them for installation access tokens, and refreshes those tokens
+// before they expire so that long-running servers keep working unattended.
+package githubapp
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/http/headers"
+	"github.com/github/github-mcp-server/pkg/utils"
+)
+
+const (
+	// DefaultFetchTimeout is the default timeout for installation token requests.
+	DefaultFetchTimeout = 10 * time.Second
+
+	// DefaultRefreshWindow is how long before expiry a cached token is refreshed.
+	DefaultRefreshWindow = 5 * time.Minute
+
+	// jwtLifetime is the lifetime of the App JWT. GitHub rejects JWTs valid for more than 10 minutes.
+	jwtLifetime = 9 * time.Minute
+
+	// jwtClockSkew backdates the JWT issue time to tolerate clock drift with GitHub.
+	jwtClockSkew = 60 * time.Second
+)
+
+// ErrInvalidPrivateKey is returned when the App private key cannot be parsed.
+var ErrInvalidPrivateKey = errors.New("invalid GitHub App private key")
+
+// InstallationTokenOptions configures an InstallationTokenSource.
+type InstallationTokenOptions struct {
+	// AppID is the numeric ID of the GitHub App.
+	AppID int64
+
+	// InstallationID is the numeric ID of the App installation to mint tokens for.
+	InstallationID int64
+
+	// PrivateKey is the PEM-encoded RSA private key of the GitHub App.
+	PrivateKey []byte
+
+	// HTTPClient is the HTTP client used to request installation tokens.
+	// If nil, a default client with DefaultFetchTimeout is used.
+	HTTPClient *http.Client
+
+	// RefreshWindow is how long before expiry a cached token is refreshed.
+	// Defaults to DefaultRefreshWindow if zero.
+	RefreshWindow time.Duration
+}
+
+// InstallationTokenSource mints and caches GitHub App installation access tokens.
+// It is safe for concurrent use.
+type InstallationTokenSource struct {
+	client         *http.Client
+	apiHost        utils.APIHostResolver
+	appID          int64
+	installationID int64
+	key            *rsa.PrivateKey
+	refreshWindow  time.Duration
+	now            func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// NewInstallationTokenSource creates a new InstallationTokenSource for the given API host.
+// Tokens are requested from the host's /app/installations/{id}/access_tokens endpoint.
+func NewInstallationTokenSource(apiHost utils.APIHostResolver, opts InstallationTokenOptions) (*InstallationTokenSource, error) {
+	if opts.AppID <= 0 {
+		return nil, errors.New("GitHub App ID must be set")
+	}
+	if opts.InstallationID <= 0 {
+		return nil, errors.New("GitHub App installation ID must be set")
+	}
+
+	key, err := parsePrivateKey(opts.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	client := opts.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: DefaultFetchTimeout}
+	}
+
+	refreshWindow := opts.RefreshWindow
+	if refreshWindow == 0 {
+		refreshWindow = DefaultRefreshWindow
+	}
+
+	return &InstallationTokenSource{
+		client:         client,
+		apiHost:        apiHost,
+		appID:          opts.AppID,
+		installationID: opts.InstallationID,
+		key:            key,
+		refreshWindow:  refreshWindow,
+		now:            time.Now,
+	}, nil
+}
+
+// Token returns a valid installation access token, minting a new one if the
+// cached token is missing or about to expire.
+func (s *InstallationTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Add(s.refreshWindow).Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	token, expiresAt, err := s.fetchInstallationToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	s.token = token
+	s.expiresAt = expiresAt
+	return s.token, nil
+}
+
+// installationTokenResponse is the subset of the access token response we use.
+type installationTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (s *InstallationTokenSource) fetchInstallationToken(ctx context.Context) (string, time.Time, error) {
+	jwt, err := s.signJWT()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	baseURL, err := s.apiHost.BaseRESTURL(ctx)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to get base REST URL: %w", err)
+	}
+
+	endpoint := baseURL.JoinPath("app", "installations", strconv.FormatInt(s.installationID, 10), "access_tokens")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), nil)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set(headers.AuthorizationHeader, "Bearer "+jwt)
+	req.Header.Set(headers.AcceptHeader, "application/vnd.github+json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to request installation token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", time.Time{}, fmt.Errorf("failed to request installation token: unexpected status %d: %s", resp.StatusCode, body)
+	}
+
+	var tokenResp installationTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to decode installation token response: %w", err)
+	}
+	if tokenResp.Token == "" {
+		return "", time.Time{}, errors.New("installation token response did not contain a token")
+	}
+
+	return tokenResp.Token, tokenResp.ExpiresAt, nil
+}
+
+// signJWT creates an RS256 signed JWT identifying the GitHub App.
+func (s *InstallationTokenSource) signJWT() (string, error) {
+	now := s.now()
+	header := map[string]string{"alg": "RS256", "typ": "JWT"}
+	claims := map[string]any{
+		"iat": now.Add(-jwtClockSkew).Unix(),
+		"exp": now.Add(jwtLifetime).Unix(),
+		"iss": strconv.FormatInt(s.appID, 10),
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT header: %w", err)
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT claims: %w", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign JWT: %w", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parsePrivateKey parses a PEM-encoded RSA private key in PKCS#1 or PKCS#8 form.
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM data found", ErrInvalidPrivateKey)
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPrivateKey, err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: key is not an RSA key", ErrInvalidPrivateKey)
+	}
+	return key, nil
+}
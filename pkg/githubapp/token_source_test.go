@@ -0,0 +1,181 @@
+package githubapp
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testAPIHostResolver struct {
+	baseURL string
+}
+
+func (t testAPIHostResolver) BaseRESTURL(_ context.Context) (*url.URL, error) {
+	return url.Parse(t.baseURL)
+}
+func (t testAPIHostResolver) GraphqlURL(_ context.Context) (*url.URL, error) {
+	return nil, nil
+}
+func (t testAPIHostResolver) UploadURL(_ context.Context) (*url.URL, error) {
+	return nil, nil
+}
+func (t testAPIHostResolver) RawURL(_ context.Context) (*url.URL, error) {
+	return nil, nil
+}
+
+func generateTestKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pemBytes := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	return key, pemBytes
+}
+
+// verifyJWT checks the RS256 signature and returns the decoded claims.
+func verifyJWT(t *testing.T, jwt string, pub *rsa.PublicKey) map[string]any {
+	t.Helper()
+	parts := strings.Split(jwt, ".")
+	require.Len(t, parts, 3)
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	require.NoError(t, rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature))
+
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var claims map[string]any
+	require.NoError(t, json.Unmarshal(claimsJSON, &claims))
+	return claims
+}
+
+// newAccessTokenServer stands in for POST /app/installations/{id}/access_tokens.
+func newAccessTokenServer(t *testing.T, pub *rsa.PublicKey, installationID int64, expiresIn time.Duration, calls *int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != fmt.Sprintf("/app/installations/%d/access_tokens", installationID) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		jwt, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		require.True(t, ok)
+		claims := verifyJWT(t, jwt, pub)
+		assert.Equal(t, "123", claims["iss"])
+
+		n := atomic.AddInt32(calls, 1)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"token":      fmt.Sprintf("ghs_token%d", n),
+			"expires_at": time.Now().Add(expiresIn).UTC().Format(time.RFC3339),
+		})
+	}))
+}
+
+func TestInstallationTokenSource_Token(t *testing.T) {
+	key, pemBytes := generateTestKey(t)
+
+	var calls int32
+	server := newAccessTokenServer(t, &key.PublicKey, 42, time.Hour, &calls)
+	defer server.Close()
+
+	source, err := NewInstallationTokenSource(testAPIHostResolver{baseURL: server.URL}, InstallationTokenOptions{
+		AppID:          123,
+		InstallationID: 42,
+		PrivateKey:     pemBytes,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	token, err := source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_token1", token)
+
+	// Cached token is reused while it is valid
+	token, err = source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_token1", token)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Token is refreshed once it enters the refresh window
+	source.now = func() time.Time { return time.Now().Add(56 * time.Minute) }
+	token, err = source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_token2", token)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestInstallationTokenSource_ErrorStatus(t *testing.T) {
+	_, pemBytes := generateTestKey(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message":"A JSON web token could not be decoded"}`))
+	}))
+	defer server.Close()
+
+	source, err := NewInstallationTokenSource(testAPIHostResolver{baseURL: server.URL}, InstallationTokenOptions{
+		AppID:          123,
+		InstallationID: 42,
+		PrivateKey:     pemBytes,
+	})
+	require.NoError(t, err)
+
+	_, err = source.Token(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected status 401")
+}
+
+func TestNewInstallationTokenSource_Validation(t *testing.T) {
+	_, pemBytes := generateTestKey(t)
+
+	tests := []struct {
+		name        string
+		opts        InstallationTokenOptions
+		expectedErr string
+	}{
+		{
+			name:        "missing app ID",
+			opts:        InstallationTokenOptions{InstallationID: 1, PrivateKey: pemBytes},
+			expectedErr: "GitHub App ID must be set",
+		},
+		{
+			name:        "missing installation ID",
+			opts:        InstallationTokenOptions{AppID: 1, PrivateKey: pemBytes},
+			expectedErr: "installation ID must be set",
+		},
+		{
+			name:        "invalid private key",
+			opts:        InstallationTokenOptions{AppID: 1, InstallationID: 1, PrivateKey: []byte("not a key")},
+			expectedErr: ErrInvalidPrivateKey.Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewInstallationTokenSource(testAPIHostResolver{baseURL: "https://api.github.com/"}, tc.opts)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/github/github-mcp-server/pkg/http/oauth"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/telemetry"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/go-chi/chi/v5"
@@ -32,6 +33,8 @@ type Handler struct {
 	oauthCfg               *oauth.Config
 	scopeFetcher           scopes.FetcherInterface
 	schemaCache            *mcp.SchemaCache
+	telemetry              *telemetry.Telemetry
+	sessions               *sessionManager
 }
 
 type HandlerOptions struct {
@@ -40,6 +43,7 @@ type HandlerOptions struct {
 	OAuthConfig            *oauth.Config
 	ScopeFetcher           scopes.FetcherInterface
 	FeatureChecker         inventory.FeatureFlagChecker
+	Telemetry              *telemetry.Telemetry
 }
 
 type HandlerOption func(*HandlerOptions)
@@ -74,6 +78,12 @@ func WithFeatureChecker(checker inventory.FeatureFlagChecker) HandlerOption {
 	}
 }
 
+func WithTelemetry(t *telemetry.Telemetry) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.Telemetry = t
+	}
+}
+
 func NewHTTPMcpHandler(
 	ctx context.Context,
 	cfg *ServerConfig,
@@ -106,7 +116,7 @@ func NewHTTPMcpHandler(
 	// when a new MCP Server is created per request in stateless mode.
 	schemaCache := mcp.NewSchemaCache()
 
-	return &Handler{
+	h := &Handler{
 		ctx:                    ctx,
 		config:                 cfg,
 		deps:                   deps,
@@ -118,7 +128,14 @@ func NewHTTPMcpHandler(
 		oauthCfg:               opts.OAuthConfig,
 		scopeFetcher:           scopeFetcher,
 		schemaCache:            schemaCache,
+		telemetry:              opts.Telemetry,
+	}
+
+	if cfg.StatefulSessions {
+		h.sessions = newSessionManager(h.newSessionServer, cfg.SessionIdleTimeout, cfg.MaxSessionsPerToken, logger)
 	}
+
+	return h
 }
 
 func (h *Handler) RegisterMiddleware(r chi.Router) {
@@ -176,6 +193,17 @@ func withInsiders(next http.Handler) http.Handler {
 }
 
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.sessions != nil {
+		h.sessions.ServeHTTP(w, r)
+		return
+	}
+
+	// Toolsets enabled at runtime live in the session's inventory, so they need a session to persist
+	if ghcontext.IsDynamicToolsets(r.Context()) {
+		http.Error(w, "dynamic toolsets require stateful sessions, start the server with --stateful-sessions", http.StatusBadRequest)
+		return
+	}
+
 	inv, err := h.inventoryFactoryFunc(r)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -187,12 +215,44 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		invToUse = inv.ForMCPRequest(methodInfo.Method, methodInfo.ItemName)
 	}
 
-	ghServer, err := h.githubMcpServerFactory(r, h.deps, invToUse, &github.MCPServerConfig{
+	ghServer, err := h.newMCPServer(r, invToUse)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	mcpHandler := mcp.NewStreamableHTTPHandler(func(_ *http.Request) *mcp.Server {
+		return ghServer
+	}, &mcp.StreamableHTTPOptions{
+		Stateless: true,
+	})
+
+	mcpHandler.ServeHTTP(w, r)
+}
+
+// newSessionServer creates the long-lived server for a new session. The inventory is
+// built from the initialize request and is not narrowed to a single method.
+func (h *Handler) newSessionServer(r *http.Request) (*mcp.Server, error) {
+	inv, err := h.inventoryFactoryFunc(r)
+	if err != nil {
+		return nil, err
+	}
+	return h.newMCPServer(r, inv)
+}
+
+func (h *Handler) newMCPServer(r *http.Request, inv *inventory.Inventory) (*mcp.Server, error) {
+	dynamicToolsets := ghcontext.IsDynamicToolsets(r.Context())
+	return h.githubMcpServerFactory(r, h.deps, inv, &github.MCPServerConfig{
 		Version:           h.config.Version,
+		DynamicToolsets:   dynamicToolsets,
+		DryRun:            h.config.DryRun,
 		Translator:        h.t,
 		ContentWindowSize: h.config.ContentWindowSize,
 		Logger:            h.logger,
 		RepoAccessTTL:     h.config.RepoAccessCacheTTL,
+		RepoPolicy:        h.config.RepoPolicy,
+		Confirmation:      h.config.Confirmation,
+		Telemetry:         h.telemetry,
 		// Explicitly set empty capabilities. inv.ForMCPRequest currently returns nothing for Initialize.
 		ServerOptions: []github.MCPServerOption{
 			func(so *mcp.ServerOptions) {
@@ -205,19 +265,13 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			},
 		},
 	})
+}
 
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+// CloseSessions closes all open sessions. It is a no-op unless stateful sessions are enabled.
+func (h *Handler) CloseSessions() {
+	if h.sessions != nil {
+		h.sessions.Close()
 	}
-
-	mcpHandler := mcp.NewStreamableHTTPHandler(func(_ *http.Request) *mcp.Server {
-		return ghServer
-	}, &mcp.StreamableHTTPOptions{
-		Stateless: true,
-	})
-
-	mcpHandler.ServeHTTP(w, r)
 }
 
 func DefaultGitHubMCPServerFactory(r *http.Request, deps github.ToolDependencies, inventory *inventory.Inventory, cfg *github.MCPServerConfig) (*mcp.Server, error) {
@@ -251,9 +305,11 @@ func InventoryFiltersForRequest(r *http.Request, builder *inventory.Builder) *in
 
 	toolsets := ghcontext.GetToolsets(ctx)
 	tools := ghcontext.GetTools(ctx)
+	dynamicToolsets := ghcontext.IsDynamicToolsets(ctx)
 
-	if len(toolsets) > 0 {
-		builder = builder.WithToolsets(github.ResolvedEnabledToolsets(false, toolsets, tools)) // No dynamic toolsets in HTTP mode
+	// In dynamic mode the session starts with only the requested toolsets, or none
+	if len(toolsets) > 0 || dynamicToolsets {
+		builder = builder.WithToolsets(github.ResolvedEnabledToolsets(dynamicToolsets, toolsets, tools))
 	}
 
 	if len(tools) > 0 {
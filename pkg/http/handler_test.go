@@ -104,6 +104,22 @@ func TestInventoryFiltersForRequest(t *testing.T) {
 			},
 			expectedTools: []string{"get_file_contents", "create_repository", "list_issues"},
 		},
+		{
+			name: "dynamic toolsets start empty",
+			contextSetup: func(ctx context.Context) context.Context {
+				return ghcontext.WithDynamicToolsets(ctx, true)
+			},
+			expectedTools: []string{},
+		},
+		{
+			name: "dynamic toolsets start with requested toolsets",
+			contextSetup: func(ctx context.Context) context.Context {
+				ctx = ghcontext.WithDynamicToolsets(ctx, true)
+				ctx = ghcontext.WithToolsets(ctx, []string{"all", "issues"})
+				return ctx
+			},
+			expectedTools: []string{"list_issues", "issue_write"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -350,3 +366,62 @@ func TestHTTPHandlerRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPHandlerToolListChangedCapability(t *testing.T) {
+	apiHost, err := utils.NewAPIHost("https://api.github.com")
+	require.NoError(t, err)
+
+	handler := NewHTTPMcpHandler(
+		context.Background(),
+		&ServerConfig{Version: "test", StatefulSessions: true},
+		github.BaseDeps{},
+		translations.NullTranslationHelper,
+		slog.Default(),
+		apiHost,
+		WithScopeFetcher(allScopesFetcher{}),
+	)
+
+	r := chi.NewRouter()
+	handler.RegisterMiddleware(r)
+	handler.RegisterRoutes(r)
+
+	server := httptest.NewServer(r)
+	t.Cleanup(func() {
+		handler.CloseSessions()
+		server.Close()
+	})
+
+	tests := []struct {
+		name                string
+		headers             map[string]string
+		expectedListChanged bool
+	}{
+		{
+			name:                "dynamic toolsets notify tool list changes",
+			headers:             map[string]string{headers.MCPDynamicToolsetsHeader: "true"},
+			expectedListChanged: true,
+		},
+		{
+			name:                "static toolsets do not",
+			expectedListChanged: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, nil)
+			session, err := client.Connect(context.Background(), &mcp.StreamableClientTransport{
+				Endpoint:             server.URL,
+				HTTPClient:           &http.Client{Transport: &authTransport{token: "ghp_capabilities", headers: tt.headers}},
+				MaxRetries:           -1,
+				DisableStandaloneSSE: true,
+			}, nil)
+			require.NoError(t, err)
+			defer session.Close()
+
+			capabilities := session.InitializeResult().Capabilities
+			require.NotNil(t, capabilities.Tools)
+			assert.Equal(t, tt.expectedListChanged, capabilities.Tools.ListChanged)
+		})
+	}
+}
@@ -9,6 +9,16 @@ const (
 	AcceptHeader = "Accept"
 	// UserAgentHeader is a standard HTTP Header.
 	UserAgentHeader = "User-Agent"
+	// ETagHeader is a standard HTTP Header.
+	ETagHeader = "ETag"
+	// LastModifiedHeader is a standard HTTP Header.
+	LastModifiedHeader = "Last-Modified"
+	// IfNoneMatchHeader is a standard HTTP Header.
+	IfNoneMatchHeader = "If-None-Match"
+	// IfModifiedSinceHeader is a standard HTTP Header.
+	IfModifiedSinceHeader = "If-Modified-Since"
+	// RangeHeader is a standard HTTP Header.
+	RangeHeader = "Range"
 
 	// ContentTypeJSON is the standard MIME type for JSON.
 	ContentTypeJSON = "application/json"
@@ -29,6 +39,9 @@ const (
 	// RequestHmacHeader is used to authenticate requests to the Raw API.
 	RequestHmacHeader = "Request-Hmac"
 
+	// FromCacheHeader is set on responses served from the conditional request cache.
+	FromCacheHeader = "X-From-Cache"
+
 	// MCP-specific headers.
 
 	// MCPReadOnlyHeader indicates whether the MCP is in read-only mode.
@@ -43,6 +56,10 @@ const (
 	MCPInsidersHeader = "X-MCP-Insiders"
 	// MCPFeaturesHeader is a comma-separated list of feature flags to enable.
 	MCPFeaturesHeader = "X-MCP-Features"
+	// MCPDynamicToolsetsHeader indicates whether toolsets are discovered and enabled at runtime.
+	MCPDynamicToolsetsHeader = "X-MCP-Dynamic-Toolsets"
+	// MCPDryRunHeader indicates whether write tools should describe their requests instead of sending them.
+	MCPDryRunHeader = "X-MCP-DryRun"
 
 	// GitHub-specific headers.
 
@@ -50,4 +67,16 @@ const (
 	GraphQLFeaturesHeader = "GraphQL-Features"
 	// GitHubAPIVersionHeader is the header used to specify the GitHub API version.
 	GitHubAPIVersionHeader = "X-GitHub-Api-Version"
+	// RateLimitLimitHeader is the maximum number of requests allowed in the current rate limit window.
+	RateLimitLimitHeader = "X-RateLimit-Limit"
+	// RateLimitRemainingHeader is the number of requests remaining in the current rate limit window.
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	// RateLimitUsedHeader is the number of requests made in the current rate limit window.
+	RateLimitUsedHeader = "X-RateLimit-Used"
+	// RateLimitResetHeader is the time the current rate limit window resets, in UTC epoch seconds.
+	RateLimitResetHeader = "X-RateLimit-Reset"
+	// RateLimitResourceHeader is the rate limit resource the request counted against (e.g. core, search, graphql).
+	RateLimitResourceHeader = "X-RateLimit-Resource"
+	// RetryAfterHeader is a standard HTTP Header indicating how many seconds to wait before retrying.
+	RetryAfterHeader = "Retry-After"
 )
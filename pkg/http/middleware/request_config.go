@@ -10,7 +10,7 @@ import (
 )
 
 // WithRequestConfig is a middleware that extracts MCP-related headers and sets them in the request context.
-// This includes readonly mode, toolsets, tools, lockdown mode, insiders mode, and feature flags.
+// This includes readonly mode, toolsets, tools, lockdown mode, insiders mode, dynamic toolsets, dry-run mode, and feature flags.
 func WithRequestConfig(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -40,6 +40,16 @@ func WithRequestConfig(next http.Handler) http.Handler {
 			ctx = ghcontext.WithInsidersMode(ctx, true)
 		}
 
+		// Dynamic toolsets
+		if relaxedParseBool(r.Header.Get(headers.MCPDynamicToolsetsHeader)) {
+			ctx = ghcontext.WithDynamicToolsets(ctx, true)
+		}
+
+		// Dry-run mode
+		if relaxedParseBool(r.Header.Get(headers.MCPDryRunHeader)) {
+			ctx = ghcontext.WithDryRun(ctx, true)
+		}
+
 		// Feature flags
 		if features := headers.ParseCommaSeparated(r.Header.Get(headers.MCPFeaturesHeader)); len(features) > 0 {
 			ctx = ghcontext.WithHeaderFeatures(ctx, features)
@@ -15,9 +15,11 @@ import (
 	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	"github.com/github/github-mcp-server/pkg/github"
 	"github.com/github/github-mcp-server/pkg/http/oauth"
+	"github.com/github/github-mcp-server/pkg/http/transport"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/lockdown"
 	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/telemetry"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/go-chi/chi/v5"
@@ -61,12 +63,43 @@ type ServerConfig struct {
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
+	// DryRun makes write tools describe the request they would send instead of sending it
+	// for every request, not only those with the X-MCP-DryRun header
+	DryRun bool
+
 	// RepoAccessCacheTTL overrides the default TTL for repository access cache entries.
 	RepoAccessCacheTTL *time.Duration
 
 	// ScopeChallenge indicates if we should return OAuth scope challenges, and if we should perform
 	// tool filtering based on token scopes.
 	ScopeChallenge bool
+
+	// RepoPolicy, when set, restricts which owners and repositories tool calls may target
+	RepoPolicy *github.RepoPolicy
+
+	// Confirmation, when set, selects tool calls the user must confirm through elicitation.
+	// Elicitation needs a session, so this is only useful with StatefulSessions.
+	Confirmation *github.ConfirmationPolicy
+
+	// ResponseCache, when set, caches REST GET responses using conditional requests.
+	// Entries are isolated per token.
+	ResponseCache transport.CacheStore
+
+	// Telemetry selects the exporters for traces and metrics. With the Prometheus
+	// metrics exporter, metrics are served at /metrics.
+	Telemetry telemetry.Config
+
+	// StatefulSessions maps Mcp-Session-Id to a long-lived server per session instead of
+	// creating a server for every request. This enables server-initiated messages and
+	// stream resumption with Last-Event-ID, at the cost of keeping sessions in memory.
+	StatefulSessions bool
+
+	// SessionIdleTimeout closes sessions that receive no requests for this long.
+	// If zero, DefaultSessionIdleTimeout is used.
+	SessionIdleTimeout time.Duration
+
+	// MaxSessionsPerToken caps the number of concurrent sessions per token. Zero means no limit.
+	MaxSessionsPerToken int
 }
 
 func RunHTTPServer(cfg ServerConfig) error {
@@ -90,7 +123,7 @@ func RunHTTPServer(cfg ServerConfig) error {
 		slogHandler = slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: slog.LevelInfo})
 	}
 	logger := slog.New(slogHandler)
-	logger.Info("starting server", "version", cfg.Version, "host", cfg.Host, "lockdownEnabled", cfg.LockdownMode)
+	logger.Info("starting server", "version", cfg.Version, "host", cfg.Host, "lockdownEnabled", cfg.LockdownMode, "dryRun", cfg.DryRun, "statefulSessions", cfg.StatefulSessions)
 
 	apiHost, err := utils.NewAPIHost(cfg.Host)
 	if err != nil {
@@ -104,6 +137,18 @@ func RunHTTPServer(cfg ServerConfig) error {
 		repoAccessOpts = append(repoAccessOpts, lockdown.WithTTL(*cfg.RepoAccessCacheTTL))
 	}
 
+	telemetryProvider, err := telemetry.NewProvider(ctx, cfg.Telemetry)
+	if err != nil {
+		return fmt.Errorf("failed to configure telemetry: %w", err)
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := telemetryProvider.Shutdown(shutdownCtx); err != nil {
+			logger.Error("failed to flush telemetry", "error", err)
+		}
+	}()
+
 	featureChecker := createHTTPFeatureChecker()
 
 	deps := github.NewRequestDeps(
@@ -115,6 +160,8 @@ func RunHTTPServer(cfg ServerConfig) error {
 		cfg.ContentWindowSize,
 		featureChecker,
 	)
+	deps.ResponseCache = cfg.ResponseCache
+	deps.Telemetry = telemetryProvider.Telemetry
 
 	// Initialize the global tool scope map
 	err = initGlobalToolScopeMap(t)
@@ -128,7 +175,7 @@ func RunHTTPServer(cfg ServerConfig) error {
 		ResourcePath: cfg.ResourcePath,
 	}
 
-	serverOptions := []HandlerOption{}
+	serverOptions := []HandlerOption{WithTelemetry(telemetryProvider.Telemetry)}
 	if cfg.ScopeChallenge {
 		scopeFetcher := scopes.NewFetcher(apiHost, scopes.FetcherOptions{})
 		serverOptions = append(serverOptions, WithScopeFetcher(scopeFetcher))
@@ -156,12 +203,19 @@ func RunHTTPServer(cfg ServerConfig) error {
 	})
 	logger.Info("OAuth protected resource endpoints registered", "baseURL", cfg.BaseURL)
 
+	if telemetryProvider.MetricsHandler != nil {
+		r.Method(http.MethodGet, "/metrics", telemetryProvider.MetricsHandler)
+		logger.Info("Prometheus metrics endpoint registered", "path", "/metrics")
+	}
+
 	addr := fmt.Sprintf(":%d", cfg.Port)
 	httpSvr := http.Server{
 		Addr:              addr,
 		Handler:           r,
 		ReadHeaderTimeout: 60 * time.Second,
 	}
+	// Close open sessions so their event streams end and shutdown is not held up
+	httpSvr.RegisterOnShutdown(handler.CloseSessions)
 
 	go func() {
 		<-ctx.Done()
@@ -0,0 +1,248 @@
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// DefaultSessionIdleTimeout is how long a session may go without requests before it is closed.
+	DefaultSessionIdleTimeout = 30 * time.Minute
+
+	// DefaultMaxSessionsPerToken is the default number of concurrent sessions allowed per token.
+	DefaultMaxSessionsPerToken = 10
+
+	// sessionIDHeader is the streamable HTTP header carrying the session ID.
+	sessionIDHeader = "Mcp-Session-Id"
+)
+
+// sessionManager serves stateful streamable HTTP sessions. Each session is backed by its
+// own long-lived MCP server, built from the initialize request, so server-initiated
+// notifications, progress, elicitation and per-session inventory changes work as they
+// do over stdio. Responses are kept in an in-memory event store so clients can resume a
+// broken stream with Last-Event-ID.
+//
+// Sessions are bound to the token that created them and may only be used with that
+// token. The number of concurrent sessions per token is capped.
+type sessionManager struct {
+	handler     *mcp.StreamableHTTPHandler
+	newServer   func(r *http.Request) (*mcp.Server, error)
+	maxPerToken int
+	logger      *slog.Logger
+
+	mu       sync.Mutex
+	owners   map[string]string // session ID -> token key
+	sessions map[string]*mcp.ServerSession
+	counts   map[string]int // token key -> open or pending sessions
+}
+
+type sessionServerKey struct{}
+
+// sessionServerSlot receives the server created for a new session while the
+// initialize request is being handled.
+type sessionServerSlot struct {
+	server *mcp.Server
+}
+
+func newSessionManager(newServer func(r *http.Request) (*mcp.Server, error), idleTimeout time.Duration, maxPerToken int, logger *slog.Logger) *sessionManager {
+	if idleTimeout == 0 {
+		idleTimeout = DefaultSessionIdleTimeout
+	}
+
+	m := &sessionManager{
+		newServer:   newServer,
+		maxPerToken: maxPerToken,
+		logger:      logger,
+		owners:      make(map[string]string),
+		sessions:    make(map[string]*mcp.ServerSession),
+		counts:      make(map[string]int),
+	}
+	m.handler = mcp.NewStreamableHTTPHandler(m.getServer, &mcp.StreamableHTTPOptions{
+		EventStore:     mcp.NewMemoryEventStore(nil),
+		SessionTimeout: idleTimeout,
+		Logger:         logger,
+	})
+	return m
+}
+
+func (m *sessionManager) getServer(r *http.Request) *mcp.Server {
+	server, err := m.newServer(r)
+	if err != nil {
+		m.logger.Error("failed to create session server", "error", err)
+		return nil
+	}
+	if slot, ok := r.Context().Value(sessionServerKey{}).(*sessionServerSlot); ok {
+		slot.server = server
+	}
+	return server
+}
+
+// ServeHTTP routes requests for existing sessions to their server and creates
+// a new session for requests without a session ID.
+func (m *sessionManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := sessionTokenKey(r.Context())
+
+	if sessionID := r.Header.Get(sessionIDHeader); sessionID != "" {
+		m.mu.Lock()
+		owner, ok := m.owners[sessionID]
+		m.mu.Unlock()
+		if ok && owner != key {
+			http.Error(w, "session belongs to a different token", http.StatusForbidden)
+			return
+		}
+		// Unknown sessions are rejected with 404 by the streamable handler
+		m.handler.ServeHTTP(w, r)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		m.handler.ServeHTTP(w, r)
+		return
+	}
+
+	if !m.reserve(key) {
+		http.Error(w, fmt.Sprintf("too many concurrent sessions for this token (limit %d)", m.maxPerToken), http.StatusTooManyRequests)
+		return
+	}
+
+	slot := &sessionServerSlot{}
+	ow := &ownerRecordingWriter{ResponseWriter: w, manager: m, key: key}
+	m.handler.ServeHTTP(ow, r.WithContext(context.WithValue(r.Context(), sessionServerKey{}, slot)))
+	m.track(key, ow.sessionID, slot.server)
+}
+
+// ownerRecordingWriter binds a new session to its token as soon as the response
+// carrying the session ID starts, so the ID can never be used by another token
+// before the session is tracked.
+type ownerRecordingWriter struct {
+	http.ResponseWriter
+	manager   *sessionManager
+	key       string
+	recorded  bool
+	sessionID string
+}
+
+func (w *ownerRecordingWriter) recordOwner() {
+	if w.recorded {
+		return
+	}
+	w.recorded = true
+	w.sessionID = w.Header().Get(sessionIDHeader)
+	if w.sessionID == "" {
+		return
+	}
+	w.manager.mu.Lock()
+	w.manager.owners[w.sessionID] = w.key
+	w.manager.mu.Unlock()
+}
+
+func (w *ownerRecordingWriter) WriteHeader(statusCode int) {
+	w.recordOwner()
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *ownerRecordingWriter) Write(b []byte) (int, error) {
+	w.recordOwner()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *ownerRecordingWriter) Flush() {
+	w.recordOwner()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *ownerRecordingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// reserve counts a new session against the token's limit, reporting whether it is allowed.
+func (m *sessionManager) reserve(key string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.maxPerToken > 0 && m.counts[key] >= m.maxPerToken {
+		return false
+	}
+	m.counts[key]++
+	return true
+}
+
+// releaseLocked returns a session reserved for the token.
+func (m *sessionManager) releaseLocked(key string) {
+	if m.counts[key] <= 1 {
+		delete(m.counts, key)
+		return
+	}
+	m.counts[key]--
+}
+
+// track records the session created on server, if any, and releases its
+// reservation when it closes, whether explicitly, on idle timeout or because
+// initialization failed. sessionID is the ID already sent to the client, if any.
+func (m *sessionManager) track(key, sessionID string, server *mcp.Server) {
+	var session *mcp.ServerSession
+	if server != nil {
+		for ss := range server.Sessions() {
+			session = ss
+		}
+	}
+	if session == nil {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if sessionID != "" {
+			delete(m.owners, sessionID)
+		}
+		m.releaseLocked(key)
+		return
+	}
+
+	id := session.ID()
+	m.mu.Lock()
+	m.owners[id] = key
+	m.sessions[id] = session
+	m.mu.Unlock()
+
+	go func() {
+		_ = session.Wait()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.owners, id)
+		delete(m.sessions, id)
+		m.releaseLocked(key)
+	}()
+}
+
+// Close closes all open sessions.
+func (m *sessionManager) Close() {
+	m.mu.Lock()
+	sessions := make([]*mcp.ServerSession, 0, len(m.sessions))
+	for _, ss := range m.sessions {
+		sessions = append(sessions, ss)
+	}
+	m.mu.Unlock()
+
+	for _, ss := range sessions {
+		_ = ss.Close()
+	}
+}
+
+// sessionTokenKey identifies the token of a request without keeping the token itself.
+func sessionTokenKey(ctx context.Context) string {
+	tokenInfo, ok := ghcontext.GetTokenInfo(ctx)
+	if !ok || tokenInfo == nil {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(tokenInfo.Token))
+	return hex.EncodeToString(sum[:])
+}
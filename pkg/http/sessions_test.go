@@ -0,0 +1,329 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/http/headers"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// authTransport adds a bearer token and any extra headers to every request.
+type authTransport struct {
+	token   string
+	headers map[string]string
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set(headers.AuthorizationHeader, "Bearer "+t.token)
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newSessionTestServer starts an HTTP server in stateful session mode whose sessions
+// expose a single "confirm" tool that elicits a confirmation from the client.
+func newSessionTestServer(t *testing.T, maxPerToken int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var serversCreated atomic.Int32
+	mcpServerFactory := func(_ *http.Request, _ github.ToolDependencies, _ *inventory.Inventory, _ *github.MCPServerConfig) (*mcp.Server, error) {
+		serversCreated.Add(1)
+		server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0.0.1"}, nil)
+		server.AddTool(&mcp.Tool{
+			Name:        "confirm",
+			InputSchema: &jsonschema.Schema{Type: "object"},
+		}, func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// Server-initiated requests are only possible with a long-lived session
+			result, err := req.Session.Elicit(ctx, &mcp.ElicitParams{
+				Message: "Proceed?",
+				RequestedSchema: &jsonschema.Schema{
+					Type:       "object",
+					Properties: map[string]*jsonschema.Schema{"confirm": {Type: "boolean"}},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: result.Action}}}, nil
+		})
+		return server, nil
+	}
+
+	inventoryFactory := func(_ *http.Request) (*inventory.Inventory, error) {
+		return inventory.NewBuilder().SetTools(testTools()).WithToolsets([]string{"all"}).Build()
+	}
+
+	apiHost, err := utils.NewAPIHost("https://api.github.com")
+	require.NoError(t, err)
+
+	handler := NewHTTPMcpHandler(
+		context.Background(),
+		&ServerConfig{Version: "test", StatefulSessions: true, MaxSessionsPerToken: maxPerToken},
+		nil,
+		translations.NullTranslationHelper,
+		slog.Default(),
+		apiHost,
+		WithInventoryFactory(inventoryFactory),
+		WithGitHubMCPServerFactory(mcpServerFactory),
+		WithScopeFetcher(allScopesFetcher{}),
+	)
+
+	r := chi.NewRouter()
+	handler.RegisterMiddleware(r)
+	handler.RegisterRoutes(r)
+
+	server := httptest.NewServer(r)
+	t.Cleanup(func() {
+		handler.CloseSessions()
+		server.Close()
+	})
+	return server, &serversCreated
+}
+
+func connectSessionClient(t *testing.T, url, token string) (*mcp.ClientSession, error) {
+	t.Helper()
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, &mcp.ClientOptions{
+		ElicitationHandler: func(_ context.Context, _ *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			return &mcp.ElicitResult{Action: "accept", Content: map[string]any{"confirm": true}}, nil
+		},
+	})
+	return client.Connect(context.Background(), &mcp.StreamableClientTransport{
+		Endpoint:             url,
+		HTTPClient:           &http.Client{Transport: &authTransport{token: token}},
+		MaxRetries:           -1,
+		DisableStandaloneSSE: true,
+	}, nil)
+}
+
+func TestStatefulSessions(t *testing.T) {
+	t.Parallel()
+
+	server, serversCreated := newSessionTestServer(t, 0)
+
+	session, err := connectSessionClient(t, server.URL, "ghp_sessiontoken")
+	require.NoError(t, err)
+	defer session.Close()
+	require.NotEmpty(t, session.ID())
+
+	for range 2 {
+		result, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: "confirm"})
+		require.NoError(t, err)
+		require.Len(t, result.Content, 1)
+		assert.Equal(t, "accept", result.Content[0].(*mcp.TextContent).Text)
+	}
+
+	// All requests of the session are served by the server created on initialize
+	assert.Equal(t, int32(1), serversCreated.Load())
+}
+
+func TestStatefulSessions_RejectsOtherTokens(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newSessionTestServer(t, 0)
+
+	session, err := connectSessionClient(t, server.URL, "ghp_owner")
+	require.NoError(t, err)
+	defer session.Close()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	require.NoError(t, err)
+	req.Header.Set(headers.AuthorizationHeader, "Bearer ghp_intruder")
+	req.Header.Set(headers.ContentTypeHeader, headers.ContentTypeJSON)
+	req.Header.Set(headers.AcceptHeader, "application/json, text/event-stream")
+	req.Header.Set(sessionIDHeader, session.ID())
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+// ownerCheckingWriter asserts that a new session is bound to its token by the
+// time the response carrying its ID is written.
+type ownerCheckingWriter struct {
+	*httptest.ResponseRecorder
+	t       *testing.T
+	manager *sessionManager
+	key     string
+	checked bool
+}
+
+func (w *ownerCheckingWriter) checkOwner() {
+	if id := w.Header().Get(sessionIDHeader); id != "" && !w.checked {
+		w.checked = true
+		w.manager.mu.Lock()
+		owner := w.manager.owners[id]
+		w.manager.mu.Unlock()
+		assert.Equal(w.t, w.key, owner, "session ID sent before its owner was recorded")
+	}
+}
+
+func (w *ownerCheckingWriter) WriteHeader(statusCode int) {
+	w.checkOwner()
+	w.ResponseRecorder.WriteHeader(statusCode)
+}
+
+func (w *ownerCheckingWriter) Write(b []byte) (int, error) {
+	w.checkOwner()
+	return w.ResponseRecorder.Write(b)
+}
+
+func TestStatefulSessions_OwnerRecordedBeforeSessionIDIsSent(t *testing.T) {
+	t.Parallel()
+
+	m := newSessionManager(func(_ *http.Request) (*mcp.Server, error) {
+		return mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0.0.1"}, nil), nil
+	}, 0, 0, slog.Default())
+	t.Cleanup(m.Close)
+
+	body := `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18","capabilities":{},"clientInfo":{"name":"test","version":"0.0.1"}}}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(headers.ContentTypeHeader, headers.ContentTypeJSON)
+	req.Header.Set(headers.AcceptHeader, "application/json, text/event-stream")
+	req = req.WithContext(ghcontext.WithTokenInfo(req.Context(), &ghcontext.TokenInfo{Token: "ghp_owner"}))
+
+	w := &ownerCheckingWriter{ResponseRecorder: httptest.NewRecorder(), t: t, manager: m, key: sessionTokenKey(req.Context())}
+	m.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, w.checked, "initialize response should carry a session ID")
+}
+
+func TestStatefulSessions_LimitPerToken(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newSessionTestServer(t, 1)
+
+	first, err := connectSessionClient(t, server.URL, "ghp_limited")
+	require.NoError(t, err)
+
+	_, err = connectSessionClient(t, server.URL, "ghp_limited")
+	require.Error(t, err, "a second session for the same token should be rejected")
+
+	// Other tokens are counted separately
+	other, err := connectSessionClient(t, server.URL, "ghp_other")
+	require.NoError(t, err)
+	defer other.Close()
+
+	// Closing a session frees its slot
+	require.NoError(t, first.Close())
+	require.Eventually(t, func() bool {
+		session, err := connectSessionClient(t, server.URL, "ghp_limited")
+		if err != nil {
+			return false
+		}
+		_ = session.Close()
+		return true
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestStatefulSessions_DynamicToolsets(t *testing.T) {
+	t.Parallel()
+
+	apiHost, err := utils.NewAPIHost("https://api.github.com")
+	require.NoError(t, err)
+
+	handler := NewHTTPMcpHandler(
+		context.Background(),
+		&ServerConfig{Version: "test", StatefulSessions: true},
+		github.BaseDeps{},
+		translations.NullTranslationHelper,
+		slog.Default(),
+		apiHost,
+		WithScopeFetcher(allScopesFetcher{}),
+	)
+
+	r := chi.NewRouter()
+	handler.RegisterMiddleware(r)
+	handler.RegisterRoutes(r)
+
+	server := httptest.NewServer(r)
+	t.Cleanup(func() {
+		handler.CloseSessions()
+		server.Close()
+	})
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, nil)
+	session, err := client.Connect(context.Background(), &mcp.StreamableClientTransport{
+		Endpoint: server.URL,
+		HTTPClient: &http.Client{Transport: &authTransport{
+			token:   "ghp_dynamic",
+			headers: map[string]string{headers.MCPDynamicToolsetsHeader: "true"},
+		}},
+		MaxRetries:           -1,
+		DisableStandaloneSSE: true,
+	}, nil)
+	require.NoError(t, err)
+	defer session.Close()
+
+	toolNames := func() []string {
+		result, err := session.ListTools(context.Background(), nil)
+		require.NoError(t, err)
+		names := make([]string, 0, len(result.Tools))
+		for _, tool := range result.Tools {
+			names = append(names, tool.Name)
+		}
+		return names
+	}
+
+	assert.ElementsMatch(t, []string{"enable_toolset", "list_available_toolsets", "get_toolset_tools"}, toolNames())
+
+	result, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "enable_toolset",
+		Arguments: map[string]any{"toolset": "labels"},
+	})
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	// The enabled toolset persists for later requests of the session
+	assert.Contains(t, toolNames(), "get_label")
+}
+
+func TestDynamicToolsetsRequireSessions(t *testing.T) {
+	t.Parallel()
+
+	apiHost, err := utils.NewAPIHost("https://api.github.com")
+	require.NoError(t, err)
+
+	handler := NewHTTPMcpHandler(
+		context.Background(),
+		&ServerConfig{Version: "test"},
+		nil,
+		translations.NullTranslationHelper,
+		slog.Default(),
+		apiHost,
+		WithScopeFetcher(allScopesFetcher{}),
+	)
+
+	r := chi.NewRouter()
+	handler.RegisterMiddleware(r)
+	handler.RegisterRoutes(r)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	req.Header.Set(headers.AuthorizationHeader, "Bearer ghp_dynamic")
+	req.Header.Set(headers.ContentTypeHeader, headers.ContentTypeJSON)
+	req.Header.Set(headers.AcceptHeader, "application/json, text/event-stream")
+	req.Header.Set(headers.MCPDynamicToolsetsHeader, "true")
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,250 @@
+package transport
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultCacheMaxSize is the default total size limit for a cache store.
+const DefaultCacheMaxSize = 64 << 20
+
+// MemoryCacheStore is an in-memory least-recently-used CacheStore with a total size limit.
+type MemoryCacheStore struct {
+	mu       sync.Mutex
+	maxBytes int64
+	size     int64
+	lru      *list.List
+	items    map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key  string
+	resp *CachedResponse
+	size int64
+}
+
+// NewMemoryCacheStore creates a MemoryCacheStore holding up to maxBytes of responses.
+// If maxBytes is zero or negative, DefaultCacheMaxSize is used.
+func NewMemoryCacheStore(maxBytes int64) *MemoryCacheStore {
+	if maxBytes <= 0 {
+		maxBytes = DefaultCacheMaxSize
+	}
+	return &MemoryCacheStore{
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get implements CacheStore.
+func (s *MemoryCacheStore) Get(key string) (*CachedResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	el, ok := s.items[key]
+	if !ok {
+		return nil, false
+	}
+	s.lru.MoveToFront(el)
+	return el.Value.(*memoryCacheEntry).resp, true
+}
+
+// Set implements CacheStore.
+func (s *MemoryCacheStore) Set(key string, resp *CachedResponse) {
+	size := resp.size() + int64(len(key))
+	if size > s.maxBytes {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.items[key]; ok {
+		s.size -= el.Value.(*memoryCacheEntry).size
+		s.lru.Remove(el)
+	}
+	s.items[key] = s.lru.PushFront(&memoryCacheEntry{key: key, resp: resp, size: size})
+	s.size += size
+
+	for s.size > s.maxBytes {
+		oldest := s.lru.Back()
+		entry := oldest.Value.(*memoryCacheEntry)
+		s.lru.Remove(oldest)
+		delete(s.items, entry.key)
+		s.size -= entry.size
+	}
+}
+
+// DiskCacheStore is a least-recently-used CacheStore that keeps responses in
+// files under a directory, with a total size limit. Entries survive restarts.
+// File names are hashes of the cache key, and files are only readable by the
+// current user since cached responses may contain private repository content.
+type DiskCacheStore struct {
+	dir      string
+	mu       sync.Mutex
+	maxBytes int64
+	size     int64
+	lru      *list.List
+	items    map[string]*list.Element
+}
+
+type diskCacheEntry struct {
+	name string
+	size int64
+}
+
+// diskCacheRecord is the on-disk format of a cached response.
+type diskCacheRecord struct {
+	Key      string          `json:"key"`
+	Response *CachedResponse `json:"response"`
+}
+
+const diskCacheSuffix = ".json"
+
+// NewDiskCacheStore creates a DiskCacheStore in dir holding up to maxBytes of responses.
+// Existing entries in dir are reused, oldest first evicted if they exceed the limit.
+// If maxBytes is zero or negative, DefaultCacheMaxSize is used.
+func NewDiskCacheStore(dir string, maxBytes int64) (*DiskCacheStore, error) {
+	if maxBytes <= 0 {
+		maxBytes = DefaultCacheMaxSize
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache directory: %w", err)
+	}
+
+	type existing struct {
+		name    string
+		size    int64
+		modTime int64
+	}
+	var files []existing
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		if !strings.HasSuffix(name, diskCacheSuffix) {
+			// Leftover temporary file from an interrupted write
+			_ = os.Remove(filepath.Join(dir, name))
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, existing{name: name, size: info.Size(), modTime: info.ModTime().UnixNano()})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime < files[j].modTime })
+
+	s := &DiskCacheStore{
+		dir:      dir,
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		items:    make(map[string]*list.Element),
+	}
+	for _, f := range files {
+		s.items[f.name] = s.lru.PushFront(&diskCacheEntry{name: f.name, size: f.size})
+		s.size += f.size
+	}
+	s.mu.Lock()
+	s.evict()
+	s.mu.Unlock()
+
+	return s, nil
+}
+
+func diskCacheFileName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:]) + diskCacheSuffix
+}
+
+// Get implements CacheStore.
+func (s *DiskCacheStore) Get(key string) (*CachedResponse, bool) {
+	name := diskCacheFileName(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	el, ok := s.items[name]
+	if !ok {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		s.remove(el)
+		return nil, false
+	}
+	var record diskCacheRecord
+	if err := json.Unmarshal(data, &record); err != nil || record.Key != key || record.Response == nil {
+		s.remove(el)
+		return nil, false
+	}
+
+	s.lru.MoveToFront(el)
+	return record.Response, true
+}
+
+// Set implements CacheStore.
+func (s *DiskCacheStore) Set(key string, resp *CachedResponse) {
+	data, err := json.Marshal(diskCacheRecord{Key: key, Response: resp})
+	if err != nil || int64(len(data)) > s.maxBytes {
+		return
+	}
+	name := diskCacheFileName(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Write to a temporary file and rename so readers never see partial entries
+	tmp, err := os.CreateTemp(s.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, name)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+
+	if el, ok := s.items[name]; ok {
+		s.size -= el.Value.(*diskCacheEntry).size
+		s.lru.Remove(el)
+	}
+	size := int64(len(data))
+	s.items[name] = s.lru.PushFront(&diskCacheEntry{name: name, size: size})
+	s.size += size
+	s.evict()
+}
+
+// evict removes the least recently used entries until the store is within its size limit.
+// The caller must hold s.mu.
+func (s *DiskCacheStore) evict() {
+	for s.size > s.maxBytes {
+		s.remove(s.lru.Back())
+	}
+}
+
+// remove deletes an entry and its file. The caller must hold s.mu.
+func (s *DiskCacheStore) remove(el *list.Element) {
+	entry := el.Value.(*diskCacheEntry)
+	s.lru.Remove(el)
+	delete(s.items, entry.name)
+	s.size -= entry.size
+	_ = os.Remove(filepath.Join(s.dir, entry.name))
+}
@@ -0,0 +1,189 @@
+package transport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/http/headers"
+)
+
+// DefaultCacheMaxEntrySize is the default size limit for a single cached response body.
+const DefaultCacheMaxEntrySize = 1 << 20
+
+// CachedResponse is a response stored by ConditionalCacheTransport.
+type CachedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+// size approximates the memory used by the cached response.
+func (c *CachedResponse) size() int64 {
+	n := int64(len(c.Body))
+	for k, values := range c.Header {
+		for _, v := range values {
+			n += int64(len(k) + len(v))
+		}
+	}
+	return n
+}
+
+// CacheStore stores responses for ConditionalCacheTransport.
+// Implementations must be safe for concurrent use and should enforce their own size limits.
+type CacheStore interface {
+	// Get returns the response stored for key, if any.
+	Get(key string) (*CachedResponse, bool)
+	// Set stores resp for key. Storing is best effort and may be skipped, e.g. if resp is too large.
+	Set(key string, resp *CachedResponse)
+}
+
+// ConditionalCacheTransport is an http.RoundTripper that caches GET responses
+// carrying an ETag or Last-Modified header and revalidates them with
+// If-None-Match / If-Modified-Since. When GitHub answers 304 Not Modified the
+// cached response is served instead. Conditional requests that return 304 do
+// not count against the primary rate limit, so repeated reads of unchanged
+// objects are effectively free.
+//
+// Cache entries are keyed by the request URL, the Accept and API version
+// headers, and a hash of the Authorization header, so responses are never
+// shared between tokens. The transport must therefore run after the
+// Authorization header has been set, i.e. be wrapped by the auth transport.
+type ConditionalCacheTransport struct {
+	// Transport is the underlying HTTP transport. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+	// Store holds cached responses. If nil, requests are passed through unchanged.
+	Store CacheStore
+	// MaxEntrySize is the largest response body that is cached. If zero, DefaultCacheMaxEntrySize is used.
+	MaxEntrySize int64
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ConditionalCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if t.Store == nil || !isCacheableRequest(req) {
+		return transport.RoundTrip(req)
+	}
+
+	key := conditionalCacheKey(req)
+	cached, found := t.Store.Get(key)
+	if found {
+		// Clone the request to avoid mutating the original
+		req = req.Clone(req.Context())
+		if etag := cached.Header.Get(headers.ETagHeader); etag != "" {
+			req.Header.Set(headers.IfNoneMatchHeader, etag)
+		}
+		if lastModified := cached.Header.Get(headers.LastModifiedHeader); lastModified != "" {
+			req.Header.Set(headers.IfModifiedSinceHeader, lastModified)
+		}
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if found && resp.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return cachedHTTPResponse(req, resp, cached), nil
+	}
+
+	if resp.StatusCode != http.StatusOK ||
+		(resp.Header.Get(headers.ETagHeader) == "" && resp.Header.Get(headers.LastModifiedHeader) == "") {
+		return resp, nil
+	}
+
+	return t.storeResponse(key, resp)
+}
+
+// storeResponse buffers the response body and stores it if it is small enough.
+func (t *ConditionalCacheTransport) storeResponse(key string, resp *http.Response) (*http.Response, error) {
+	maxSize := t.MaxEntrySize
+	if maxSize == 0 {
+		maxSize = DefaultCacheMaxEntrySize
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+
+	if int64(len(body)) > maxSize {
+		// Too large to cache: hand back what was read followed by the rest of the stream
+		resp.Body = &multiReadCloser{Reader: io.MultiReader(bytes.NewReader(body), resp.Body), Closer: resp.Body}
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+
+	t.Store.Set(key, &CachedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	})
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+// isCacheableRequest reports whether req is a plain GET request that the transport may cache.
+// Requests that are already conditional or ask for a byte range are passed through.
+func isCacheableRequest(req *http.Request) bool {
+	return req.Method == http.MethodGet &&
+		req.Header.Get(headers.RangeHeader) == "" &&
+		req.Header.Get(headers.IfNoneMatchHeader) == "" &&
+		req.Header.Get(headers.IfModifiedSinceHeader) == ""
+}
+
+// conditionalCacheKey returns the cache key for req. The Authorization header is
+// hashed so tokens are isolated from each other without being stored.
+func conditionalCacheKey(req *http.Request) string {
+	token := sha256.Sum256([]byte(req.Header.Get(headers.AuthorizationHeader)))
+	return strings.Join([]string{
+		hex.EncodeToString(token[:]),
+		req.Header.Get(headers.AcceptHeader),
+		req.Header.Get(headers.GitHubAPIVersionHeader),
+		req.URL.String(),
+	}, "\x00")
+}
+
+// cachedHTTPResponse builds a response from a cached entry, updated with the
+// headers of the 304 response (e.g. fresh rate limit headers).
+func cachedHTTPResponse(req *http.Request, notModified *http.Response, cached *CachedResponse) *http.Response {
+	header := cached.Header.Clone()
+	for k, values := range notModified.Header {
+		switch k {
+		case "Content-Length", "Content-Type", "Content-Encoding", "Transfer-Encoding":
+			continue
+		}
+		header[k] = values
+	}
+	header.Set(headers.FromCacheHeader, "1")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", cached.StatusCode, http.StatusText(cached.StatusCode)),
+		StatusCode:    cached.StatusCode,
+		Proto:         notModified.Proto,
+		ProtoMajor:    notModified.ProtoMajor,
+		ProtoMinor:    notModified.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(cached.Body)),
+		ContentLength: int64(len(cached.Body)),
+		Request:       req,
+	}
+}
+
+type multiReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,190 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/http/headers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newETagServer returns a server that serves body with the given ETag and
+// answers matching If-None-Match requests with 304.
+func newETagServer(t *testing.T, etag *atomic.Value, body string, calls, notModified *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		current := etag.Load().(string)
+		w.Header().Set(headers.RateLimitRemainingHeader, "4999")
+		if r.Header.Get(headers.IfNoneMatchHeader) == current {
+			notModified.Add(1)
+			w.Header().Set(headers.ETagHeader, current)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set(headers.ETagHeader, current)
+		w.Header().Set(headers.ContentTypeHeader, headers.ContentTypeJSON)
+		_, _ = w.Write([]byte(body + current))
+	}))
+}
+
+func doGet(t *testing.T, client *http.Client, url, token string) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set(headers.AuthorizationHeader, "Bearer "+token)
+	}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+func TestConditionalCacheTransport(t *testing.T) {
+	t.Parallel()
+
+	var etag atomic.Value
+	etag.Store(`"v1"`)
+	var calls, notModified atomic.Int32
+	server := newETagServer(t, &etag, `{"name":"repo"}`, &calls, &notModified)
+	defer server.Close()
+
+	client := &http.Client{Transport: &ConditionalCacheTransport{Store: NewMemoryCacheStore(0)}}
+
+	resp, body := doGet(t, client, server.URL+"/repos/owner/repo", "token-a")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"name":"repo"}"v1"`, body)
+	assert.Empty(t, resp.Header.Get(headers.FromCacheHeader))
+
+	// Second request revalidates and is served from the cache
+	resp, body = doGet(t, client, server.URL+"/repos/owner/repo", "token-a")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"name":"repo"}"v1"`, body)
+	assert.Equal(t, "1", resp.Header.Get(headers.FromCacheHeader))
+	assert.Equal(t, "4999", resp.Header.Get(headers.RateLimitRemainingHeader))
+	assert.Equal(t, headers.ContentTypeJSON, resp.Header.Get(headers.ContentTypeHeader))
+	assert.Equal(t, int32(1), notModified.Load())
+
+	// A different token never sees another token's cached entry
+	resp, _ = doGet(t, client, server.URL+"/repos/owner/repo", "token-b")
+	assert.Empty(t, resp.Header.Get(headers.FromCacheHeader))
+	assert.Equal(t, int32(1), notModified.Load())
+
+	// A changed resource replaces the cached entry
+	etag.Store(`"v2"`)
+	resp, body = doGet(t, client, server.URL+"/repos/owner/repo", "token-a")
+	assert.Empty(t, resp.Header.Get(headers.FromCacheHeader))
+	assert.Equal(t, `{"name":"repo"}"v2"`, body)
+
+	resp, body = doGet(t, client, server.URL+"/repos/owner/repo", "token-a")
+	assert.Equal(t, "1", resp.Header.Get(headers.FromCacheHeader))
+	assert.Equal(t, `{"name":"repo"}"v2"`, body)
+
+	assert.Equal(t, int32(5), calls.Load())
+}
+
+func TestConditionalCacheTransport_SkipsUncacheableResponses(t *testing.T) {
+	t.Parallel()
+
+	largeBody := strings.Repeat("x", 64)
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Empty(t, r.Header.Get(headers.IfNoneMatchHeader), "uncacheable responses should not be revalidated")
+		switch r.URL.Path {
+		case "/no-etag":
+			_, _ = w.Write([]byte("no etag"))
+		case "/large":
+			w.Header().Set(headers.ETagHeader, `"large"`)
+			_, _ = w.Write([]byte(largeBody))
+		case "/error":
+			w.Header().Set(headers.ETagHeader, `"error"`)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &ConditionalCacheTransport{Store: NewMemoryCacheStore(0), MaxEntrySize: 32}}
+
+	for _, path := range []string{"/no-etag", "/large", "/error"} {
+		for range 2 {
+			resp, body := doGet(t, client, server.URL+path, "token")
+			assert.Empty(t, resp.Header.Get(headers.FromCacheHeader), path)
+			if path == "/large" {
+				assert.Equal(t, largeBody, body, "large bodies should be passed through intact")
+			}
+		}
+	}
+	assert.Equal(t, int32(6), calls.Load())
+}
+
+func TestMemoryCacheStore_EvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	entry := func(body string) *CachedResponse {
+		return &CachedResponse{StatusCode: http.StatusOK, Header: http.Header{}, Body: []byte(body)}
+	}
+
+	store := NewMemoryCacheStore(30)
+	store.Set("a", entry("0123456789"))
+	store.Set("b", entry("0123456789"))
+
+	// Touch a so b becomes the least recently used entry
+	_, ok := store.Get("a")
+	require.True(t, ok)
+
+	store.Set("c", entry("0123456789"))
+
+	_, ok = store.Get("a")
+	assert.True(t, ok)
+	_, ok = store.Get("b")
+	assert.False(t, ok)
+	_, ok = store.Get("c")
+	assert.True(t, ok)
+
+	// Entries larger than the whole store are ignored
+	store.Set("huge", entry(strings.Repeat("x", 100)))
+	_, ok = store.Get("huge")
+	assert.False(t, ok)
+}
+
+func TestDiskCacheStore(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	resp := &CachedResponse{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{headers.ETagHeader: []string{`"v1"`}},
+		Body:       []byte(`{"name":"repo"}`),
+	}
+
+	store, err := NewDiskCacheStore(dir, 0)
+	require.NoError(t, err)
+	store.Set("key", resp)
+
+	got, ok := store.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, resp, got)
+
+	// Entries survive a restart
+	reopened, err := NewDiskCacheStore(dir, 0)
+	require.NoError(t, err)
+	got, ok = reopened.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, resp, got)
+
+	// Reopening with a smaller limit evicts entries that no longer fit
+	small, err := NewDiskCacheStore(dir, 10)
+	require.NoError(t, err)
+	_, ok = small.Get("key")
+	assert.False(t, ok)
+}
@@ -0,0 +1,72 @@
+package transport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+)
+
+// ErrDryRun is returned for write requests that were recorded instead of sent.
+var ErrDryRun = errors.New("request not sent: dry-run mode")
+
+// DryRunTransport is an http.RoundTripper that stops write requests from
+// reaching the GitHub API when the request context carries a
+// ghcontext.DryRunRecorder. Such requests are recorded on the recorder and
+// fail with ErrDryRun, so the caller stops before acting on a response it
+// never received. Read requests, including GraphQL queries, are sent as
+// usual so tools can still validate their input and resolve IDs.
+//
+// Requests whose context has no recorder are passed through unchanged.
+type DryRunTransport struct {
+	// Transport is the underlying HTTP transport. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *DryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	recorder, ok := ghcontext.GetDryRunRecorder(req.Context())
+	if !ok || !isWriteRequest(req) {
+		return transport.RoundTrip(req)
+	}
+
+	planned := ghcontext.PlannedRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		if json.Valid(body) {
+			planned.Body = body
+		} else {
+			planned.BodySize = len(body)
+		}
+	}
+	recorder.Record(planned)
+
+	return nil, ErrDryRun
+}
+
+// isWriteRequest reports whether req may change state on GitHub.
+func isWriteRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	case http.MethodPost:
+		return !isGraphQLQuery(req)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,65 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunTransport(t *testing.T) {
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &DryRunTransport{}}
+	ctx, recorder := ghcontext.WithDryRunRecorder(context.Background())
+
+	do := func(ctx context.Context, method, path, body string) error {
+		req, err := http.NewRequestWithContext(ctx, method, server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+
+	// Reads are sent
+	require.NoError(t, do(ctx, http.MethodGet, "/repos/owner/repo", ""))
+	require.NoError(t, do(ctx, http.MethodPost, "/graphql", `{"query":"query { viewer { login } }"}`))
+
+	// Writes are recorded
+	err := do(ctx, http.MethodPatch, "/repos/owner/repo/issues/1", `{"state":"closed"}`)
+	require.ErrorIs(t, err, ErrDryRun)
+	err = do(ctx, http.MethodPost, "/graphql", `{"query":"mutation { addStar(input: {}) { clientMutationId } }"}`)
+	require.ErrorIs(t, err, ErrDryRun)
+	err = do(ctx, http.MethodPost, "/upload", "not json")
+	require.ErrorIs(t, err, ErrDryRun)
+
+	// Without a recorder writes are sent
+	require.NoError(t, do(context.Background(), http.MethodDelete, "/repos/owner/repo", ""))
+
+	assert.Equal(t, []string{
+		"GET /repos/owner/repo",
+		"POST /graphql",
+		"DELETE /repos/owner/repo",
+	}, sent)
+
+	planned := recorder.Requests()
+	require.Len(t, planned, 3)
+	assert.Equal(t, http.MethodPatch, planned[0].Method)
+	assert.Equal(t, server.URL+"/repos/owner/repo/issues/1", planned[0].URL)
+	assert.JSONEq(t, `{"state":"closed"}`, string(planned[0].Body))
+	assert.Contains(t, string(planned[1].Body), "mutation")
+	assert.Nil(t, planned[2].Body)
+	assert.Equal(t, len("not json"), planned[2].BodySize)
+}
@@ -0,0 +1,258 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/github/github-mcp-server/pkg/http/headers"
+)
+
+const (
+	// DefaultRateLimitMaxRetries is the default number of retries after a rate limited response.
+	DefaultRateLimitMaxRetries = 3
+	// DefaultRateLimitMaxWait is the default longest time to wait before a single retry.
+	DefaultRateLimitMaxWait = time.Minute
+	// DefaultRateLimitBaseBackoff is the default initial backoff when GitHub does not say how long to wait.
+	DefaultRateLimitBaseBackoff = time.Second
+
+	// maxErrorBodyPeek limits how much of an error response is read to detect secondary rate limits.
+	maxErrorBodyPeek = 64 * 1024
+)
+
+// RateLimitTransport is an http.RoundTripper that handles GitHub primary and
+// secondary rate limits. Rate limited responses (429, or 403 with an exhausted
+// quota, a Retry-After header or a secondary rate limit message) to idempotent
+// requests are retried after the delay GitHub asks for, or with jittered
+// exponential backoff when no delay is given. If the required wait is longer
+// than MaxWait, or retries are exhausted, the rate limited response is returned
+// to the caller unchanged.
+//
+// The rate limit headers of every response are recorded on the request
+// context's ghcontext.RateLimitRecorder, if present, so remaining quota can be
+// reported back to the client.
+//
+// Requests are considered idempotent if they use GET, HEAD, OPTIONS, PUT or
+// DELETE, or are GraphQL queries (but not mutations) sent to a /graphql endpoint.
+type RateLimitTransport struct {
+	// Transport is the underlying HTTP transport. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+	// MaxRetries is the maximum number of retries. If zero, DefaultRateLimitMaxRetries is used.
+	MaxRetries int
+	// MaxWait is the longest time to wait before a single retry. If zero, DefaultRateLimitMaxWait is used.
+	MaxWait time.Duration
+	// BaseBackoff is the initial backoff when no delay is given. If zero, DefaultRateLimitBaseBackoff is used.
+	BaseBackoff time.Duration
+
+	// now and sleep are overridden in tests
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	maxRetries := t.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultRateLimitMaxRetries
+	}
+	maxWait := t.MaxWait
+	if maxWait == 0 {
+		maxWait = DefaultRateLimitMaxWait
+	}
+
+	ctx := req.Context()
+	retryable := isIdempotentRequest(req)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		recordRateLimit(ctx, resp.Header, t.currentTime)
+
+		limited, wait := t.rateLimitWait(resp, attempt)
+		if !limited || !retryable || attempt >= maxRetries || wait > maxWait {
+			return resp, nil
+		}
+
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+
+		// Drain the body so the connection can be reused
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBodyPeek))
+		_ = resp.Body.Close()
+
+		if err := t.wait(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *RateLimitTransport) currentTime() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+func (t *RateLimitTransport) wait(ctx context.Context, d time.Duration) error {
+	if t.sleep != nil {
+		return t.sleep(ctx, d)
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitWait reports whether resp is a rate limited response and how long to wait before retrying.
+func (t *RateLimitTransport) rateLimitWait(resp *http.Response, attempt int) (bool, time.Duration) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusForbidden {
+		return false, 0
+	}
+
+	// Retry-After is sent for secondary rate limits
+	if retryAfter := resp.Header.Get(headers.RetryAfterHeader); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return true, time.Duration(seconds) * time.Second
+		}
+		if at, err := http.ParseTime(retryAfter); err == nil {
+			return true, max(at.Sub(t.currentTime()), 0)
+		}
+	}
+
+	// An exhausted primary rate limit resets at X-RateLimit-Reset
+	if resp.Header.Get(headers.RateLimitRemainingHeader) == "0" {
+		if reset, err := strconv.ParseInt(resp.Header.Get(headers.RateLimitResetHeader), 10, 64); err == nil {
+			return true, max(time.Unix(reset, 0).Sub(t.currentTime()), 0) + time.Second
+		}
+		return true, t.backoff(attempt)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || isSecondaryRateLimitBody(resp) {
+		return true, t.backoff(attempt)
+	}
+
+	return false, 0
+}
+
+// backoff returns an exponential backoff with jitter for the given attempt.
+func (t *RateLimitTransport) backoff(attempt int) time.Duration {
+	base := t.BaseBackoff
+	if base == 0 {
+		base = DefaultRateLimitBaseBackoff
+	}
+	d := base << attempt
+	// #nosec G404 - jitter does not need a cryptographically secure source
+	return d/2 + rand.N(d/2+1)
+}
+
+// peekedBody is a response body whose first bytes have already been read.
+// Reads return those bytes followed by the rest of the original body.
+type peekedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// isSecondaryRateLimitBody reports whether a 403 response body mentions a secondary rate limit.
+// The peeked bytes are put back in front of the rest of the body so the caller can still read all of it.
+func isSecondaryRateLimitBody(resp *http.Response) bool {
+	if resp.Body == nil {
+		return false
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyPeek))
+	resp.Body = peekedBody{
+		Reader: io.MultiReader(bytes.NewReader(body), resp.Body),
+		Closer: resp.Body,
+	}
+	if err != nil {
+		return false
+	}
+	return bytes.Contains(bytes.ToLower(body), []byte("secondary rate limit"))
+}
+
+// isIdempotentRequest reports whether req can safely be sent again.
+func isIdempotentRequest(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return isGraphQLQuery(req)
+	default:
+		return false
+	}
+}
+
+// isGraphQLQuery reports whether req is a GraphQL query rather than a mutation.
+func isGraphQLQuery(req *http.Request) bool {
+	if !strings.HasSuffix(req.URL.Path, "/graphql") || req.GetBody == nil {
+		return false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	defer body.Close()
+
+	var payload struct {
+		Query string `json:"query"`
+	}
+	if err := json.NewDecoder(body).Decode(&payload); err != nil {
+		return false
+	}
+	query := strings.TrimSpace(payload.Query)
+	return query != "" && !strings.HasPrefix(query, "mutation")
+}
+
+// recordRateLimit records the rate limit headers of a response on the context's recorder.
+func recordRateLimit(ctx context.Context, h http.Header, now func() time.Time) {
+	limit, err := strconv.Atoi(h.Get(headers.RateLimitLimitHeader))
+	if err != nil {
+		return
+	}
+	remaining, _ := strconv.Atoi(h.Get(headers.RateLimitRemainingHeader))
+	used, _ := strconv.Atoi(h.Get(headers.RateLimitUsedHeader))
+
+	reset := now()
+	if epoch, err := strconv.ParseInt(h.Get(headers.RateLimitResetHeader), 10, 64); err == nil {
+		reset = time.Unix(epoch, 0)
+	}
+
+	resource := h.Get(headers.RateLimitResourceHeader)
+	if resource == "" {
+		resource = "core"
+	}
+
+	ghcontext.RecordRateLimit(ctx, ghcontext.RateLimit{
+		Resource:  resource,
+		Limit:     limit,
+		Remaining: remaining,
+		Used:      used,
+		Reset:     reset.UTC(),
+	})
+}
@@ -0,0 +1,298 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/github/github-mcp-server/pkg/http/headers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRateLimitTransport(now time.Time, waits *[]time.Duration) *RateLimitTransport {
+	return &RateLimitTransport{
+		Transport: http.DefaultTransport,
+		now:       func() time.Time { return now },
+		sleep: func(_ context.Context, d time.Duration) error {
+			*waits = append(*waits, d)
+			return nil
+		},
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		limitedHeaders map[string]string
+		limitedStatus  int
+		limitedBody    string
+		limitedTimes   int
+		expectedStatus int
+		expectedCalls  int
+		expectedWaits  []time.Duration
+	}{
+		{
+			name:           "retry after header",
+			method:         http.MethodGet,
+			path:           "/search/code",
+			limitedHeaders: map[string]string{headers.RetryAfterHeader: "2"},
+			limitedStatus:  http.StatusForbidden,
+			limitedTimes:   1,
+			expectedStatus: http.StatusOK,
+			expectedCalls:  2,
+			expectedWaits:  []time.Duration{2 * time.Second},
+		},
+		{
+			name:   "primary rate limit waits until reset",
+			method: http.MethodGet,
+			path:   "/repos/owner/repo",
+			limitedHeaders: map[string]string{
+				headers.RateLimitRemainingHeader: "0",
+				headers.RateLimitResetHeader:     strconv.FormatInt(now.Add(10*time.Second).Unix(), 10),
+			},
+			limitedStatus:  http.StatusForbidden,
+			limitedTimes:   1,
+			expectedStatus: http.StatusOK,
+			expectedCalls:  2,
+			expectedWaits:  []time.Duration{11 * time.Second},
+		},
+		{
+			name:   "primary rate limit reset too far away",
+			method: http.MethodGet,
+			path:   "/repos/owner/repo",
+			limitedHeaders: map[string]string{
+				headers.RateLimitRemainingHeader: "0",
+				headers.RateLimitResetHeader:     strconv.FormatInt(now.Add(time.Hour).Unix(), 10),
+			},
+			limitedStatus:  http.StatusForbidden,
+			limitedTimes:   1,
+			expectedStatus: http.StatusForbidden,
+			expectedCalls:  1,
+		},
+		{
+			name:           "retries are bounded",
+			method:         http.MethodGet,
+			path:           "/search/code",
+			limitedHeaders: map[string]string{headers.RetryAfterHeader: "1"},
+			limitedStatus:  http.StatusTooManyRequests,
+			limitedTimes:   10,
+			expectedStatus: http.StatusTooManyRequests,
+			expectedCalls:  DefaultRateLimitMaxRetries + 1,
+			expectedWaits:  []time.Duration{time.Second, time.Second, time.Second},
+		},
+		{
+			name:           "non idempotent request is not retried",
+			method:         http.MethodPost,
+			path:           "/repos/owner/repo/issues",
+			body:           `{"title":"bug"}`,
+			limitedHeaders: map[string]string{headers.RetryAfterHeader: "1"},
+			limitedStatus:  http.StatusForbidden,
+			limitedTimes:   1,
+			expectedStatus: http.StatusForbidden,
+			expectedCalls:  1,
+		},
+		{
+			name:           "graphql query is retried",
+			method:         http.MethodPost,
+			path:           "/graphql",
+			body:           `{"query":"query($owner:String!){viewer{login}}"}`,
+			limitedHeaders: map[string]string{headers.RetryAfterHeader: "1"},
+			limitedStatus:  http.StatusForbidden,
+			limitedTimes:   1,
+			expectedStatus: http.StatusOK,
+			expectedCalls:  2,
+			expectedWaits:  []time.Duration{time.Second},
+		},
+		{
+			name:           "graphql mutation is not retried",
+			method:         http.MethodPost,
+			path:           "/graphql",
+			body:           `{"query":"mutation($input:AddCommentInput!){addComment(input:$input){clientMutationId}}"}`,
+			limitedHeaders: map[string]string{headers.RetryAfterHeader: "1"},
+			limitedStatus:  http.StatusForbidden,
+			limitedTimes:   1,
+			expectedStatus: http.StatusForbidden,
+			expectedCalls:  1,
+		},
+		{
+			name:           "permission denied is not retried",
+			method:         http.MethodGet,
+			path:           "/repos/owner/repo",
+			limitedStatus:  http.StatusForbidden,
+			limitedBody:    `{"message":"Resource not accessible by integration"}`,
+			limitedTimes:   1,
+			expectedStatus: http.StatusForbidden,
+			expectedCalls:  1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(calls.Add(1))
+				if tc.body != "" {
+					body, err := io.ReadAll(r.Body)
+					assert.NoError(t, err)
+					assert.Equal(t, tc.body, string(body), "request body should be replayed on retry")
+				}
+				if n <= tc.limitedTimes {
+					for k, v := range tc.limitedHeaders {
+						w.Header().Set(k, v)
+					}
+					w.WriteHeader(tc.limitedStatus)
+					_, _ = w.Write([]byte(tc.limitedBody))
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			var waits []time.Duration
+			client := &http.Client{Transport: newTestRateLimitTransport(now, &waits)}
+
+			var body io.Reader
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			req, err := http.NewRequest(tc.method, server.URL+tc.path, body)
+			require.NoError(t, err)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tc.expectedCalls, int(calls.Load()))
+			assert.Equal(t, tc.expectedWaits, waits)
+
+			if tc.limitedBody != "" {
+				responseBody, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tc.limitedBody, string(responseBody), "response body should still be readable")
+			}
+		})
+	}
+}
+
+func TestRateLimitTransport_SecondaryRateLimitBackoff(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	rt := newTestRateLimitTransport(time.Now(), &waits)
+	rt.BaseBackoff = 100 * time.Millisecond
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/search/code", nil)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: rt}).Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, waits, 2)
+	// Jittered backoff stays within [d/2, d] and grows with each attempt
+	assert.GreaterOrEqual(t, waits[0], 50*time.Millisecond)
+	assert.LessOrEqual(t, waits[0], 100*time.Millisecond)
+	assert.GreaterOrEqual(t, waits[1], 100*time.Millisecond)
+	assert.LessOrEqual(t, waits[1], 200*time.Millisecond)
+}
+
+func TestRateLimitTransport_LargeForbiddenBodyIsNotTruncated(t *testing.T) {
+	t.Parallel()
+
+	body := `{"message":"Resource not accessible","padding":"` + strings.Repeat("x", 2*maxErrorBodyPeek) + `"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/repos/owner/repo", nil)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: newTestRateLimitTransport(time.Now(), &waits)}).Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Empty(t, waits)
+	responseBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(responseBody))
+}
+
+func TestRateLimitTransport_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(headers.RetryAfterHeader, "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	_, err = (&RateLimitTransport{}).RoundTrip(req)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRateLimitTransport_RecordsRateLimit(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(headers.RateLimitLimitHeader, "30")
+		w.Header().Set(headers.RateLimitRemainingHeader, "28")
+		w.Header().Set(headers.RateLimitUsedHeader, "2")
+		w.Header().Set(headers.RateLimitResetHeader, "1700000060")
+		w.Header().Set(headers.RateLimitResourceHeader, "search")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx, recorder := ghcontext.WithRateLimitRecorder(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: &RateLimitTransport{}}).Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, []ghcontext.RateLimit{{
+		Resource:  "search",
+		Limit:     30,
+		Remaining: 28,
+		Used:      2,
+		Reset:     time.Unix(1700000060, 0).UTC(),
+	}}, recorder.RateLimits())
+}
@@ -0,0 +1,42 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/http/headers"
+)
+
+// TokenSource supplies the token used to authenticate a request.
+// Implementations may return a different token on each call, e.g. to refresh
+// short-lived GitHub App installation tokens.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceTransport is an http.RoundTripper that sets a bearer Authorization
+// header using a token obtained from Source for every request.
+type TokenSourceTransport struct {
+	// Transport is the underlying HTTP transport. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+	Source    TokenSource
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *TokenSourceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	token, err := t.Source.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain token: %w", err)
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set(headers.AuthorizationHeader, "Bearer "+token)
+
+	return transport.RoundTrip(req)
+}
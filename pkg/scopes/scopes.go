@@ -20,6 +20,9 @@ const (
 	// PublicRepo grants access to public repositories
 	PublicRepo Scope = "public_repo"
 
+	// RepoDeployment grants access to deployments and deployment statuses
+	RepoDeployment Scope = "repo_deployment"
+
 	// ReadOrg grants read-only access to organization membership, teams, and projects
 	ReadOrg Scope = "read:org"
 
@@ -64,7 +67,7 @@ const (
 // A parent scope implicitly grants access to all child scopes.
 // For example, "repo" grants access to "public_repo" and "security_events".
 var ScopeHierarchy = map[Scope][]Scope{
-	Repo:          {PublicRepo, SecurityEvents},
+	Repo:          {PublicRepo, RepoDeployment, SecurityEvents},
 	AdminOrg:      {WriteOrg, ReadOrg},
 	WriteOrg:      {ReadOrg},
 	Project:       {ReadProject},
@@ -142,6 +142,7 @@ func TestScopeHierarchy(t *testing.T) {
 	// Verify the hierarchy is correctly defined
 	assert.Contains(t, ScopeHierarchy[Repo], PublicRepo)
 	assert.Contains(t, ScopeHierarchy[Repo], SecurityEvents)
+	assert.Contains(t, ScopeHierarchy[Repo], RepoDeployment)
 	assert.Contains(t, ScopeHierarchy[AdminOrg], WriteOrg)
 	assert.Contains(t, ScopeHierarchy[AdminOrg], ReadOrg)
 	assert.Contains(t, ScopeHierarchy[WriteOrg], ReadOrg)
@@ -163,11 +164,12 @@ func TestExpandScopeSet(t *testing.T) {
 			expected: map[string]bool{},
 		},
 		{
-			name:   "repo expands to include public_repo and security_events",
+			name:   "repo expands to include public_repo, repo_deployment and security_events",
 			scopes: []string{"repo"},
 			expected: map[string]bool{
 				"repo":            true,
 				"public_repo":     true,
+				"repo_deployment": true,
 				"security_events": true,
 			},
 		},
@@ -210,6 +212,7 @@ func TestExpandScopeSet(t *testing.T) {
 			expected: map[string]bool{
 				"repo":            true,
 				"public_repo":     true,
+				"repo_deployment": true,
 				"security_events": true,
 				"gist":            true,
 			},
@@ -0,0 +1,167 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// Exporter names accepted in Config.
+const (
+	ExporterNone       = "none"
+	ExporterOTLP       = "otlp"
+	ExporterPrometheus = "prometheus"
+)
+
+// Config selects where telemetry is exported.
+type Config struct {
+	// ServiceName and ServiceVersion identify the server in exported telemetry.
+	// Both can be overridden with the standard OTEL_SERVICE_NAME and
+	// OTEL_RESOURCE_ATTRIBUTES environment variables.
+	ServiceName    string
+	ServiceVersion string
+
+	// TracesExporter is "otlp" or "none". Empty means "none".
+	TracesExporter string
+
+	// MetricsExporter is "otlp", "prometheus" or "none". Empty means "none".
+	MetricsExporter string
+}
+
+// Validate checks that the configured exporters are supported.
+func (c Config) Validate() error {
+	switch c.TracesExporter {
+	case "", ExporterNone, ExporterOTLP:
+	default:
+		return fmt.Errorf("unsupported traces exporter %q: must be one of %q, %q", c.TracesExporter, ExporterOTLP, ExporterNone)
+	}
+	switch c.MetricsExporter {
+	case "", ExporterNone, ExporterOTLP, ExporterPrometheus:
+	default:
+		return fmt.Errorf("unsupported metrics exporter %q: must be one of %q, %q, %q", c.MetricsExporter, ExporterOTLP, ExporterPrometheus, ExporterNone)
+	}
+	return nil
+}
+
+// Enabled reports whether any exporter is configured.
+func (c Config) Enabled() bool {
+	return (c.TracesExporter != "" && c.TracesExporter != ExporterNone) ||
+		(c.MetricsExporter != "" && c.MetricsExporter != ExporterNone)
+}
+
+// Provider owns the exporters created for a Config.
+type Provider struct {
+	// Telemetry instruments the server. It is nil when no exporter is configured.
+	Telemetry *Telemetry
+
+	// MetricsHandler serves metrics in the Prometheus text format.
+	// It is nil unless the metrics exporter is "prometheus".
+	MetricsHandler http.Handler
+
+	shutdown []func(context.Context) error
+}
+
+// NewProvider creates the exporters selected by cfg. OTLP exporters send data
+// over HTTP and are configured with the standard OTEL_EXPORTER_OTLP_*
+// environment variables. The Provider must be shut down to flush buffered data.
+func NewProvider(ctx context.Context, cfg Config) (*Provider, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := &Provider{}
+	if !cfg.Enabled() {
+		return p, nil
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", cfg.ServiceName),
+			attribute.String("service.version", cfg.ServiceVersion),
+		),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create telemetry resource: %w", err)
+	}
+
+	var tp trace.TracerProvider = tracenoop.NewTracerProvider()
+	if cfg.TracesExporter == ExporterOTLP {
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+		}
+		sdkProvider := sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithResource(res),
+		)
+		p.shutdown = append(p.shutdown, sdkProvider.Shutdown)
+		tp = sdkProvider
+	}
+
+	var mp metric.MeterProvider = metricnoop.NewMeterProvider()
+	switch cfg.MetricsExporter {
+	case ExporterOTLP:
+		exporter, err := otlpmetrichttp.New(ctx)
+		if err != nil {
+			_ = p.Shutdown(ctx)
+			return nil, fmt.Errorf("failed to create OTLP metric exporter: %w", err)
+		}
+		sdkProvider := sdkmetric.NewMeterProvider(
+			sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)),
+			sdkmetric.WithResource(res),
+		)
+		p.shutdown = append(p.shutdown, sdkProvider.Shutdown)
+		mp = sdkProvider
+	case ExporterPrometheus:
+		// Use a dedicated registry so only this server's metrics are exposed
+		registry := prometheus.NewRegistry()
+		exporter, err := otelprometheus.New(otelprometheus.WithRegisterer(registry))
+		if err != nil {
+			_ = p.Shutdown(ctx)
+			return nil, fmt.Errorf("failed to create Prometheus metric exporter: %w", err)
+		}
+		sdkProvider := sdkmetric.NewMeterProvider(
+			sdkmetric.WithReader(exporter),
+			sdkmetric.WithResource(res),
+		)
+		p.shutdown = append(p.shutdown, sdkProvider.Shutdown)
+		p.MetricsHandler = promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+		mp = sdkProvider
+	}
+
+	p.Telemetry, err = New(tp, mp)
+	if err != nil {
+		_ = p.Shutdown(ctx)
+		return nil, err
+	}
+	return p, nil
+}
+
+// Shutdown flushes and stops all exporters.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	var errs []error
+	for _, shutdown := range p.shutdown {
+		if err := shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	p.shutdown = nil
+	return errors.Join(errs...)
+}
@@ -0,0 +1,193 @@
+// Package telemetry instruments the MCP server with OpenTelemetry traces and metrics.
+//
+// A Telemetry records a server span and an operation duration for every MCP
+// request, and a client span, request count and duration for every GitHub
+// API round trip made while handling it. The span of each round trip is a
+// child of the span of the MCP request that caused it.
+//
+// A nil *Telemetry is valid and disables instrumentation, so callers don't
+// need to check whether telemetry is configured.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/http/headers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies this package as the source of spans and metrics.
+const instrumentationName = "github.com/github/github-mcp-server"
+
+// Attribute keys. MCP attributes follow the OpenTelemetry semantic conventions for MCP.
+const (
+	attrMCPMethodName    = attribute.Key("mcp.method.name")
+	attrToolName         = attribute.Key("gen_ai.tool.name")
+	attrErrorType        = attribute.Key("error.type")
+	attrHTTPMethod       = attribute.Key("http.request.method")
+	attrHTTPStatusCode   = attribute.Key("http.response.status_code")
+	attrServerAddress    = attribute.Key("server.address")
+	attrURLPath          = attribute.Key("url.path")
+	attrGitHubAPI        = attribute.Key("github.api")
+	attrRateLimitRemains = attribute.Key("github.rate_limit.remaining")
+)
+
+// Telemetry creates spans and records metrics for MCP requests and GitHub API calls.
+type Telemetry struct {
+	tracer             trace.Tracer
+	operationDuration  metric.Float64Histogram
+	apiRequests        metric.Int64Counter
+	apiRequestDuration metric.Float64Histogram
+}
+
+// New creates a Telemetry that reports to the given providers.
+func New(tp trace.TracerProvider, mp metric.MeterProvider) (*Telemetry, error) {
+	meter := mp.Meter(instrumentationName)
+
+	operationDuration, err := meter.Float64Histogram("mcp.server.operation.duration",
+		metric.WithDescription("Duration of MCP requests handled by the server"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create operation duration histogram: %w", err)
+	}
+
+	apiRequests, err := meter.Int64Counter("github.api.requests",
+		metric.WithDescription("Number of requests made to the GitHub API"),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API request counter: %w", err)
+	}
+
+	apiRequestDuration, err := meter.Float64Histogram("github.api.request.duration",
+		metric.WithDescription("Duration of requests made to the GitHub API"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API request duration histogram: %w", err)
+	}
+
+	return &Telemetry{
+		tracer:             tp.Tracer(instrumentationName),
+		operationDuration:  operationDuration,
+		apiRequests:        apiRequests,
+		apiRequestDuration: apiRequestDuration,
+	}, nil
+}
+
+// Middleware returns MCP receiving middleware that creates a server span for each
+// request and records its duration. Tool calls are named after the tool, and tool
+// results with IsError set are reported as errors.
+func (t *Telemetry) Middleware() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		if t == nil {
+			return next
+		}
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			attrs := []attribute.KeyValue{attrMCPMethodName.String(method)}
+			spanName := method
+			if call, ok := req.(*mcp.CallToolRequest); ok && call.Params != nil {
+				attrs = append(attrs, attrToolName.String(call.Params.Name))
+				spanName += " " + call.Params.Name
+			}
+
+			ctx, span := t.tracer.Start(ctx, spanName,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(attrs...))
+			defer span.End()
+
+			start := time.Now()
+			result, err := next(ctx, method, req)
+
+			switch {
+			case err != nil:
+				attrs = append(attrs, attrErrorType.String(fmt.Sprintf("%T", err)))
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			case isToolError(result):
+				attrs = append(attrs, attrErrorType.String("tool_error"))
+				span.SetStatus(codes.Error, "tool returned an error")
+			}
+			span.SetAttributes(attrs...)
+
+			t.operationDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+			return result, err
+		}
+	}
+}
+
+func isToolError(result mcp.Result) bool {
+	callResult, ok := result.(*mcp.CallToolResult)
+	return ok && callResult != nil && callResult.IsError
+}
+
+// Transport wraps base so that each GitHub API round trip creates a client span
+// and is counted in the API request metrics. If t is nil, base is returned unchanged.
+func (t *Telemetry) Transport(base http.RoundTripper) http.RoundTripper {
+	if t == nil {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &roundTripper{telemetry: t, base: base}
+}
+
+type roundTripper struct {
+	telemetry *Telemetry
+	base      http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	api := "rest"
+	if strings.HasSuffix(req.URL.Path, "/graphql") {
+		api = "graphql"
+	}
+
+	metricAttrs := []attribute.KeyValue{
+		attrGitHubAPI.String(api),
+		attrHTTPMethod.String(req.Method),
+	}
+
+	ctx, span := rt.telemetry.tracer.Start(req.Context(), "github."+api+" "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(metricAttrs...),
+		trace.WithAttributes(
+			attrServerAddress.String(req.URL.Host),
+			attrURLPath.String(req.URL.Path),
+		))
+	defer span.End()
+
+	start := time.Now()
+	resp, err := rt.base.RoundTrip(req.WithContext(ctx))
+	duration := time.Since(start).Seconds()
+
+	if err != nil {
+		metricAttrs = append(metricAttrs, attrErrorType.String(fmt.Sprintf("%T", err)))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		metricAttrs = append(metricAttrs, attrHTTPStatusCode.Int(resp.StatusCode))
+		span.SetAttributes(attrHTTPStatusCode.Int(resp.StatusCode))
+		if remaining, convErr := strconv.Atoi(resp.Header.Get(headers.RateLimitRemainingHeader)); convErr == nil {
+			span.SetAttributes(attrRateLimitRemains.Int(remaining))
+		}
+		if resp.StatusCode >= http.StatusBadRequest {
+			metricAttrs = append(metricAttrs, attrErrorType.String(strconv.Itoa(resp.StatusCode)))
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		}
+	}
+
+	rt.telemetry.apiRequests.Add(ctx, 1, metric.WithAttributes(metricAttrs...))
+	rt.telemetry.apiRequestDuration.Record(ctx, duration, metric.WithAttributes(metricAttrs...))
+
+	return resp, err
+}
@@ -0,0 +1,204 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/http/headers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestTelemetry(t *testing.T) (*Telemetry, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	t.Helper()
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	tel, err := New(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+	)
+	require.NoError(t, err)
+	return tel, spans, reader
+}
+
+func collectMetrics(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	metrics := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	return metrics
+}
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestTelemetry_ToolCallWithAPIRequests(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headers.RateLimitRemainingHeader, "4999")
+		if r.URL.Path == "/graphql" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	tel, spans, reader := newTestTelemetry(t)
+	client := &http.Client{Transport: tel.Transport(nil)}
+
+	handler := tel.Middleware()(func(ctx context.Context, _ string, _ mcp.Request) (mcp.Result, error) {
+		for _, path := range []string{"/repos/owner/repo", "/graphql"} {
+			method := http.MethodGet
+			if path == "/graphql" {
+				method = http.MethodPost
+			}
+			req, err := http.NewRequestWithContext(ctx, method, server.URL+path, nil)
+			require.NoError(t, err)
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+		}
+		return &mcp.CallToolResult{IsError: true}, nil
+	})
+
+	_, err := handler(context.Background(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "get_repository"},
+	})
+	require.NoError(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 3)
+	rest, graphql, tool := ended[0], ended[1], ended[2]
+
+	assert.Equal(t, "tools/call get_repository", tool.Name())
+	assert.Equal(t, trace.SpanKindServer, tool.SpanKind())
+	assert.Equal(t, "get_repository", spanAttr(tool, attrToolName).AsString())
+	assert.Equal(t, "tool_error", spanAttr(tool, attrErrorType).AsString())
+	assert.Equal(t, codes.Error, tool.Status().Code)
+
+	// API request spans are children of the tool call span
+	assert.Equal(t, "github.rest GET", rest.Name())
+	assert.Equal(t, trace.SpanKindClient, rest.SpanKind())
+	assert.Equal(t, tool.SpanContext().SpanID(), rest.Parent().SpanID())
+	assert.Equal(t, int64(http.StatusNotFound), spanAttr(rest, attrHTTPStatusCode).AsInt64())
+	assert.Equal(t, int64(4999), spanAttr(rest, attrRateLimitRemains).AsInt64())
+	assert.Equal(t, "/repos/owner/repo", spanAttr(rest, attrURLPath).AsString())
+	assert.Equal(t, codes.Error, rest.Status().Code)
+
+	assert.Equal(t, "github.graphql POST", graphql.Name())
+	assert.Equal(t, tool.SpanContext().SpanID(), graphql.Parent().SpanID())
+	assert.Equal(t, codes.Unset, graphql.Status().Code)
+
+	metrics := collectMetrics(t, reader)
+
+	requests, ok := metrics["github.api.requests"].(metricdata.Sum[int64])
+	require.True(t, ok)
+	var total int64
+	for _, dp := range requests.DataPoints {
+		total += dp.Value
+	}
+	assert.Equal(t, int64(2), total)
+
+	apiDuration, ok := metrics["github.api.request.duration"].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	assert.Len(t, apiDuration.DataPoints, 2)
+
+	opDuration, ok := metrics["mcp.server.operation.duration"].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, opDuration.DataPoints, 1)
+	assert.Equal(t, uint64(1), opDuration.DataPoints[0].Count)
+	toolName, _ := opDuration.DataPoints[0].Attributes.Value(attrToolName)
+	assert.Equal(t, "get_repository", toolName.AsString())
+}
+
+func TestTelemetry_MiddlewareError(t *testing.T) {
+	t.Parallel()
+
+	tel, spans, _ := newTestTelemetry(t)
+	handler := tel.Middleware()(func(context.Context, string, mcp.Request) (mcp.Result, error) {
+		return nil, errors.New("boom")
+	})
+
+	_, err := handler(context.Background(), "resources/read", &mcp.ReadResourceRequest{})
+	require.Error(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	assert.Equal(t, "resources/read", ended[0].Name())
+	assert.Equal(t, codes.Error, ended[0].Status().Code)
+	assert.Equal(t, "boom", ended[0].Status().Description)
+}
+
+func TestTelemetry_Nil(t *testing.T) {
+	t.Parallel()
+
+	var tel *Telemetry
+	base := http.DefaultTransport
+	assert.Equal(t, base, tel.Transport(base))
+
+	called := false
+	handler := tel.Middleware()(func(context.Context, string, mcp.Request) (mcp.Result, error) {
+		called = true
+		return nil, nil
+	})
+	_, err := handler(context.Background(), "tools/list", nil)
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewProvider(context.Background(), Config{})
+	require.NoError(t, err)
+	assert.Nil(t, p.Telemetry)
+	assert.Nil(t, p.MetricsHandler)
+
+	_, err = NewProvider(context.Background(), Config{TracesExporter: "jaeger"})
+	require.ErrorContains(t, err, "unsupported traces exporter")
+
+	_, err = NewProvider(context.Background(), Config{MetricsExporter: "statsd"})
+	require.ErrorContains(t, err, "unsupported metrics exporter")
+
+	p, err = NewProvider(context.Background(), Config{ServiceName: "github-mcp-server", MetricsExporter: ExporterPrometheus})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, p.Shutdown(context.Background())) }()
+	require.NotNil(t, p.Telemetry)
+	require.NotNil(t, p.MetricsHandler)
+
+	handler := p.Telemetry.Middleware()(func(context.Context, string, mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{}, nil
+	})
+	_, err = handler(context.Background(), "tools/call", &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "get_me"}})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	p.MetricsHandler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "mcp_server_operation_duration_seconds")
+	assert.Contains(t, rec.Body.String(), `gen_ai_tool_name="get_me"`)
+}